@@ -142,14 +142,14 @@ resource "linode_instance" "nginx" {
     label  = "nginx"
     kernel = "linode/latest-64bit"
 
-    devices {
-      sda = {
-        disk_label = "boot"
-      }
-
-      sdb = {
-        volume_id = "${element(linode_volume.nginx-vol.*.id, count.index)}"
-      }
+    device {
+      slot       = "sda"
+      disk_label = "boot"
+    }
+
+    device {
+      slot      = "sdb"
+      volume_id = "${element(linode_volume.nginx-vol.*.id, count.index)}"
     }
   }
 
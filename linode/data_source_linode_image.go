@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/linode/linodego"
 )
 
 func dataSourceLinodeImage() *schema.Resource {
@@ -73,7 +72,7 @@ func dataSourceLinodeImage() *schema.Resource {
 }
 
 func dataSourceLinodeImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	reqImage := d.Get("id").(string)
 
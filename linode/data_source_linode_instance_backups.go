@@ -0,0 +1,213 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/linode/linodego"
+)
+
+func dataSourceLinodeInstanceBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeInstanceBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"linode_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to list the Backups of.",
+				Required:    true,
+			},
+			"automatic": {
+				Type:        schema.TypeList,
+				Description: "The automatic Backups of the Linode Instance.",
+				Computed:    true,
+				Elem:        dataSourceLinodeInstanceBackupResource(),
+			},
+			"snapshot": {
+				Type:        schema.TypeList,
+				Description: "The manual Snapshots of the Linode Instance.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current": {
+							Type:        schema.TypeList,
+							Description: "The current Snapshot of the Linode Instance.",
+							Computed:    true,
+							Elem:        dataSourceLinodeInstanceBackupResource(),
+						},
+						"in_progress": {
+							Type:        schema.TypeList,
+							Description: "The Snapshot of the Linode Instance that is being taken.",
+							Computed:    true,
+							Elem:        dataSourceLinodeInstanceBackupResource(),
+						},
+					},
+				},
+			},
+			"most_recent": {
+				Type:        schema.TypeList,
+				Description: "The most recent successful Backup or Snapshot of the Linode Instance.",
+				Computed:    true,
+				Elem:        dataSourceLinodeInstanceBackupResource(),
+			},
+		},
+	}
+}
+
+// dataSourceLinodeInstanceBackupResource describes a single Backup or Snapshot of a Linode Instance
+func dataSourceLinodeInstanceBackupResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Backup.",
+				Computed:    true,
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Description: "The label of the Backup. Only manual Snapshots have a label.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the Backup.",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "Whether the Backup is automatic or a manual Snapshot.",
+				Computed:    true,
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Description: "When the Backup was created.",
+				Computed:    true,
+			},
+			"finished": {
+				Type:        schema.TypeString,
+				Description: "When the Backup finished.",
+				Computed:    true,
+			},
+			"configs": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels of the Configs included in the Backup.",
+				Computed:    true,
+			},
+			"disks": {
+				Type:        schema.TypeList,
+				Description: "The Disks included in the Backup.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Type:        schema.TypeString,
+							Description: "The label of the source Disk.",
+							Computed:    true,
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Description: "The size of the source Disk in MB.",
+							Computed:    true,
+						},
+						"filesystem": {
+							Type:        schema.TypeString,
+							Description: "The filesystem of the source Disk.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLinodeInstanceBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	linodeID := d.Get("linode_id").(int)
+
+	backups, err := client.GetInstanceBackups(context.Background(), linodeID)
+	if err != nil {
+		return fmt.Errorf("Error listing the backups of Linode Instance %d: %s", linodeID, err)
+	}
+
+	automatic := make([]map[string]interface{}, 0, len(backups.Automatic))
+	candidates := make([]*linodego.InstanceSnapshot, 0, len(backups.Automatic)+1)
+	for _, backup := range backups.Automatic {
+		if backup == nil {
+			continue
+		}
+		automatic = append(automatic, flattenInstanceBackup(backup))
+		candidates = append(candidates, backup)
+	}
+
+	snapshot := map[string]interface{}{
+		"current":     []map[string]interface{}{},
+		"in_progress": []map[string]interface{}{},
+	}
+	if backups.Snapshot != nil {
+		if backups.Snapshot.Current != nil {
+			snapshot["current"] = []map[string]interface{}{flattenInstanceBackup(backups.Snapshot.Current)}
+			candidates = append(candidates, backups.Snapshot.Current)
+		}
+		if backups.Snapshot.InProgress != nil {
+			snapshot["in_progress"] = []map[string]interface{}{flattenInstanceBackup(backups.Snapshot.InProgress)}
+		}
+	}
+
+	mostRecent := []map[string]interface{}{}
+	if backup := mostRecentInstanceBackup(candidates); backup != nil {
+		mostRecent = append(mostRecent, flattenInstanceBackup(backup))
+	}
+
+	d.SetId(strconv.Itoa(linodeID))
+
+	if err := d.Set("automatic", automatic); err != nil {
+		return fmt.Errorf("Error setting Linode Instance automatic backups: %s", err)
+	}
+	if err := d.Set("snapshot", []map[string]interface{}{snapshot}); err != nil {
+		return fmt.Errorf("Error setting Linode Instance snapshots: %s", err)
+	}
+	if err := d.Set("most_recent", mostRecent); err != nil {
+		return fmt.Errorf("Error setting Linode Instance most recent backup: %s", err)
+	}
+
+	return nil
+}
+
+// flattenInstanceBackup converts a Backup or Snapshot of a Linode Instance to a map
+func flattenInstanceBackup(backup *linodego.InstanceSnapshot) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"id":      backup.ID,
+		"label":   backup.Label,
+		"status":  string(backup.Status),
+		"type":    backup.Type,
+		"configs": backup.Configs,
+		"disks":   flattenInstanceSnapshotDisks(backup.Disks),
+	}
+	if backup.Created != nil {
+		flattened["created"] = backup.Created.Format(time.RFC3339)
+	}
+	if backup.Finished != nil {
+		flattened["finished"] = backup.Finished.Format(time.RFC3339)
+	}
+	return flattened
+}
+
+// mostRecentInstanceBackup returns the successful Backup that was created last, or nil if there is none
+func mostRecentInstanceBackup(backups []*linodego.InstanceSnapshot) *linodego.InstanceSnapshot {
+	var mostRecent *linodego.InstanceSnapshot
+	for _, backup := range backups {
+		if backup.Status != linodego.SnapshotSuccessful || backup.Created == nil {
+			continue
+		}
+		if mostRecent == nil || backup.Created.After(*mostRecent.Created) {
+			mostRecent = backup
+		}
+	}
+	return mostRecent
+}
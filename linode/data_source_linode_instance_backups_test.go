@@ -0,0 +1,82 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/linode/linodego"
+)
+
+func TestAccDataSourceLinodeInstanceBackups_mostRecent(t *testing.T) {
+	t.Parallel()
+
+	older, newer := time.Now().Add(-48*time.Hour), time.Now().Add(-24*time.Hour)
+	newest := time.Now()
+
+	backups := []*linodego.InstanceSnapshot{
+		{ID: 1, Status: linodego.SnapshotSuccessful, Created: &older},
+		{ID: 2, Status: linodego.SnapshotSuccessful, Created: &newer},
+		{ID: 3, Status: linodego.SnapshotFailed, Created: &newest},
+	}
+
+	if backup := mostRecentInstanceBackup(backups); backup == nil || backup.ID != 2 {
+		t.Errorf("expected the newest successful backup 2, got %v", backup)
+	}
+
+	if backup := mostRecentInstanceBackup(backups[2:]); backup != nil {
+		t.Errorf("expected no backup when none succeeded, got %v", backup)
+	}
+}
+
+func TestAccDataSourceLinodeInstanceBackups(t *testing.T) {
+	t.Parallel()
+
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	snapshotName := acctest.RandomWithPrefix("tf_test")
+	resourceName := "data.linode_instance_backups.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeInstanceBackups(instanceName, snapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "snapshot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot.0.current.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot.0.current.0.label", snapshotName),
+					resource.TestCheckResourceAttr(resourceName, "snapshot.0.current.0.status", "successful"),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot.0.current.0.id", "linode_instance_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "most_recent.0.id", "linode_instance_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "most_recent.0.created"),
+					resource.TestCheckResourceAttrSet(resourceName, "most_recent.0.disks.0.size"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLinodeInstanceBackups(instance string, snapshot string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	backups_enabled = true
+}
+
+resource "linode_instance_snapshot" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "%s"
+}
+
+data "linode_instance_backups" "foobar" {
+	linode_id = "${linode_instance_snapshot.foobar.linode_id}"
+}`, instance, snapshot)
+}
@@ -0,0 +1,46 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeIPv6Pools() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeIPv6PoolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Only list the IPv6 pools in this Region.",
+				Optional:    true,
+			},
+			"pools": {
+				Type:        schema.TypeList,
+				Description: "The IPv6 pools shared by the Linode Instances of each Region.",
+				Computed:    true,
+				Elem:        dataSourceLinodeIPv6RangeResource(),
+			},
+		},
+	}
+}
+
+func dataSourceLinodeIPv6PoolsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	pools, err := client.ListIPv6Pools(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("Error listing IPv6 pools: %s", err)
+	}
+
+	region := d.Get("region").(string)
+
+	d.SetId(ipv6RangesID("ipv6_pools", region))
+	if err := d.Set("pools", flattenIPv6Ranges(pools, region)); err != nil {
+		return fmt.Errorf("Error setting IPv6 pools: %s", err)
+	}
+
+	return nil
+}
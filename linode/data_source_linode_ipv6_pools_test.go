@@ -0,0 +1,32 @@
+package linode
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceLinodeIPv6Pools(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.linode_ipv6_pools.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeIPv6Pools(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "ipv6_pools"),
+					resource.TestCheckResourceAttrSet(resourceName, "pools.#"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLinodeIPv6Pools() string {
+	return `
+data "linode_ipv6_pools" "foobar" {}`
+}
@@ -0,0 +1,120 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/linode/linodego"
+)
+
+func dataSourceLinodeIPv6Ranges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeIPv6RangesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Only list the IPv6 ranges in this Region.",
+				Optional:    true,
+			},
+			"ranges": {
+				Type:        schema.TypeList,
+				Description: "The IPv6 ranges routed to the Linode Instances of the account.",
+				Computed:    true,
+				Elem:        dataSourceLinodeIPv6RangeResource(),
+			},
+		},
+	}
+}
+
+// dataSourceLinodeIPv6RangeResource describes a single IPv6 range or pool
+func dataSourceLinodeIPv6RangeResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"range": {
+				Type:        schema.TypeString,
+				Description: "The first address of the IPv6 range.",
+				Computed:    true,
+			},
+			"prefix": {
+				Type:        schema.TypeInt,
+				Description: "The prefix length of the IPv6 range.",
+				Computed:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The Region of the IPv6 range.",
+				Computed:    true,
+			},
+			"route_target": {
+				Type:        schema.TypeString,
+				Description: "The address the IPv6 range is routed to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeIPv6RangesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	ranges, err := client.ListIPv6Ranges(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("Error listing IPv6 ranges: %s", err)
+	}
+
+	region := d.Get("region").(string)
+
+	d.SetId(ipv6RangesID("ipv6_ranges", region))
+	if err := d.Set("ranges", flattenIPv6Ranges(ranges, region)); err != nil {
+		return fmt.Errorf("Error setting IPv6 ranges: %s", err)
+	}
+
+	return nil
+}
+
+// ipv6RangesID identifies a list of IPv6 ranges by the region it is limited to
+func ipv6RangesID(kind string, region string) string {
+	if region == "" {
+		return kind
+	}
+	return fmt.Sprintf("%s:%s", kind, region)
+}
+
+// flattenIPv6Ranges converts the IPv6 ranges in a region, or in all regions when the region is empty, to maps
+func flattenIPv6Ranges(ranges []linodego.IPv6Range, region string) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(ranges))
+	for _, r := range ranges {
+		if region != "" && r.Region != region {
+			continue
+		}
+		flattened = append(flattened, flattenIPv6Range(r))
+	}
+	return flattened
+}
+
+// flattenIPv6Range converts an IPv6 range to a map, splitting a prefix given in CIDR notation from its address
+func flattenIPv6Range(r linodego.IPv6Range) map[string]interface{} {
+	address, prefix := splitIPv6Range(r)
+	return map[string]interface{}{
+		"range":        address,
+		"prefix":       prefix,
+		"region":       r.Region,
+		"route_target": r.RouteTarget,
+	}
+}
+
+// splitIPv6Range returns the first address and prefix length of an IPv6 range, which the API may return as "address/prefix"
+func splitIPv6Range(r linodego.IPv6Range) (string, int) {
+	address, prefix := r.Range, r.Prefix
+	if parts := strings.SplitN(r.Range, "/", 2); len(parts) == 2 {
+		address = parts[0]
+		if prefix == 0 {
+			prefix, _ = strconv.Atoi(parts[1])
+		}
+	}
+	return address, prefix
+}
@@ -0,0 +1,73 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/linode/linodego"
+)
+
+func TestAccDataSourceLinodeIPv6Ranges_flatten(t *testing.T) {
+	t.Parallel()
+
+	ranges := []linodego.IPv6Range{
+		{Range: "2600:3c01::/64", Region: "us-west", RouteTarget: "2600:3c01::f03c:91ff:fe24:3a2f"},
+		{Range: "2600:3c03::/56", Region: "us-east"},
+	}
+
+	if flattened := flattenIPv6Ranges(ranges, ""); len(flattened) != 2 {
+		t.Errorf("expected 2 ranges without a region filter, got %d", len(flattened))
+	}
+
+	flattened := flattenIPv6Ranges(ranges, "us-east")
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 range in us-east, got %d", len(flattened))
+	}
+	if flattened[0]["range"] != "2600:3c03::" || flattened[0]["prefix"] != 56 {
+		t.Errorf("expected range 2600:3c03:: with prefix 56, got %v", flattened[0])
+	}
+}
+
+func TestAccDataSourceLinodeIPv6Ranges(t *testing.T) {
+	t.Parallel()
+
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	resourceName := "data.linode_ipv6_ranges.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLinodeIPv6Ranges(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "ipv6_ranges:us-east"),
+					resource.TestCheckResourceAttrSet(resourceName, "ranges.0.range"),
+					resource.TestCheckResourceAttr(resourceName, "ranges.0.region", "us-east"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLinodeIPv6Ranges(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_ipv6_range" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	prefix_length = 64
+}
+
+data "linode_ipv6_ranges" "foobar" {
+	region = "${linode_ipv6_range.foobar.region}"
+}`, instance)
+}
@@ -0,0 +1,21 @@
+package linode
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// expandIntSet converts a set of ints, such as entity IDs, to a sorted slice
+func expandIntSet(v interface{}) []int {
+	set, ok := v.(*schema.Set)
+	if !ok || set == nil {
+		return nil
+	}
+	ints := make([]int, 0, set.Len())
+	for _, i := range set.List() {
+		ints = append(ints, i.(int))
+	}
+	sort.Ints(ints)
+	return ints
+}
@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/linode/linodego"
@@ -21,6 +23,26 @@ var (
 	boolTrue  = true
 )
 
+// instanceConfigDeviceSlots lists the device slots of a Linode Instance Config in slot order, and
+// instanceConfigDeviceFields maps each slot to its field in linodego.InstanceConfigDeviceMap.
+// Both are derived from the linodego struct so slots added to the API only need a linodego update.
+var instanceConfigDeviceSlots, instanceConfigDeviceFields = indexInstanceConfigDeviceMap()
+
+func indexInstanceConfigDeviceMap() ([]string, map[string]int) {
+	t := reflect.TypeOf(linodego.InstanceConfigDeviceMap{})
+	slots := make([]string, 0, t.NumField())
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		slot := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if slot == "" || slot == "-" {
+			continue
+		}
+		slots = append(slots, slot)
+		fields[slot] = i
+	}
+	return slots, fields
+}
+
 func flattenInstanceSpecs(instance linodego.Instance) []map[string]int {
 	return []map[string]int{{
 		"vcpus":    instance.Specs.VCPUs,
@@ -30,6 +52,68 @@ func flattenInstanceSpecs(instance linodego.Instance) []map[string]int {
 	}}
 }
 
+// flattenInstanceIPs converts the addresses of a Linode Instance to maps, keeping the order returned by the API
+func flattenInstanceIPs(ips []*linodego.InstanceIP) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(ips))
+	for _, ip := range ips {
+		if ip == nil {
+			continue
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"address":     ip.Address,
+			"gateway":     ip.Gateway,
+			"subnet_mask": ip.SubnetMask,
+			"prefix":      ip.Prefix,
+			"type":        ip.Type,
+			"public":      ip.Public,
+			"rdns":        ip.RDNS,
+			"linode_id":   ip.LinodeID,
+			"region":      ip.Region,
+		})
+	}
+	return flattened
+}
+
+// setInstanceIPAddresses sets the structured IPv4 and IPv6 address lists of a linode_instance
+func setInstanceIPAddresses(d *schema.ResourceData, network *linodego.InstanceIPAddressResponse) error {
+	addresses := map[string]interface{}{
+		"ipv4_public":     flattenInstanceIPs(nil),
+		"ipv4_private":    flattenInstanceIPs(nil),
+		"ipv4_shared":     flattenInstanceIPs(nil),
+		"ipv4_reserved":   flattenInstanceIPs(nil),
+		"ipv6_slaac":      flattenInstanceIPs(nil),
+		"ipv6_link_local": flattenInstanceIPs(nil),
+		"ipv6_ranges":     flattenIPv6Ranges(nil, ""),
+	}
+
+	if network.IPv4 != nil {
+		addresses["ipv4_public"] = flattenInstanceIPs(network.IPv4.Public)
+		addresses["ipv4_private"] = flattenInstanceIPs(network.IPv4.Private)
+		addresses["ipv4_shared"] = flattenInstanceIPs(network.IPv4.Shared)
+		addresses["ipv4_reserved"] = flattenInstanceIPs(network.IPv4.Reserved)
+	}
+
+	if network.IPv6 != nil {
+		addresses["ipv6_slaac"] = flattenInstanceIPs([]*linodego.InstanceIP{network.IPv6.SLAAC})
+		addresses["ipv6_link_local"] = flattenInstanceIPs([]*linodego.InstanceIP{network.IPv6.LinkLocal})
+
+		ranges := make([]linodego.IPv6Range, 0, len(network.IPv6.Global))
+		for _, r := range network.IPv6.Global {
+			if r != nil {
+				ranges = append(ranges, *r)
+			}
+		}
+		addresses["ipv6_ranges"] = flattenIPv6Ranges(ranges, "")
+	}
+
+	for key, value := range addresses {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("Error setting %s of Linode Instance %s: %s", key, d.Id(), err)
+		}
+	}
+	return nil
+}
+
 func flattenInstanceAlerts(instance linodego.Instance) []map[string]int {
 	return []map[string]int{{
 		"cpu":            instance.Alerts.CPU,
@@ -59,6 +143,20 @@ func flattenInstanceBackups(instance linodego.Instance) flattenedInstanceBackup
 	}}
 }
 
+// backupScheduleDays are the days of the week a weekly Backup may be taken on
+var backupScheduleDays = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// backupScheduleWindows are the two-hour UTC windows a Backup may be taken in
+var backupScheduleWindows = []string{"W0", "W2", "W4", "W6", "W8", "W10", "W12", "W14", "W16", "W18", "W20", "W22"}
+
+// expandInstanceBackupSchedule returns the backup schedule configured on a linode_instance
+func expandInstanceBackupSchedule(d *schema.ResourceData) *linodego.InstanceBackup {
+	backups := &linodego.InstanceBackup{}
+	backups.Schedule.Day = d.Get("backups.0.schedule.0.day").(string)
+	backups.Schedule.Window = d.Get("backups.0.schedule.0.window").(string)
+	return backups
+}
+
 func flattenInstanceDisks(instanceDisks []linodego.InstanceDisk) (disks []map[string]interface{}, swapSize int) {
 	for _, disk := range instanceDisks {
 		// Determine if swap exists and the size.  If it does not exist, swap_size=0
@@ -84,17 +182,6 @@ func flattenInstanceDisks(instanceDisks []linodego.InstanceDisk) (disks []map[st
 func flattenInstanceConfigs(instanceConfigs []linodego.InstanceConfig, diskLabelIDMap map[int]string) (configs []map[string]interface{}) {
 	for _, config := range instanceConfigs {
 
-		devices := []map[string]interface{}{{
-			"sda": flattenInstanceConfigDevice(config.Devices.SDA, diskLabelIDMap),
-			"sdb": flattenInstanceConfigDevice(config.Devices.SDB, diskLabelIDMap),
-			"sdc": flattenInstanceConfigDevice(config.Devices.SDC, diskLabelIDMap),
-			"sdd": flattenInstanceConfigDevice(config.Devices.SDD, diskLabelIDMap),
-			"sde": flattenInstanceConfigDevice(config.Devices.SDE, diskLabelIDMap),
-			"sdf": flattenInstanceConfigDevice(config.Devices.SDF, diskLabelIDMap),
-			"sdg": flattenInstanceConfigDevice(config.Devices.SDG, diskLabelIDMap),
-			"sdh": flattenInstanceConfigDevice(config.Devices.SDH, diskLabelIDMap),
-		}}
-
 		// Determine if swap exists and the size.  If it does not exist, swap_size=0
 		c := map[string]interface{}{
 			"root_device":  "/dev/root",
@@ -111,7 +198,7 @@ func flattenInstanceConfigs(instanceConfigs []linodego.InstanceConfig, diskLabel
 				"network":            config.Helpers.Network,
 				"devtmpfs_automount": config.Helpers.DevTmpFsAutomount,
 			}},
-			"devices": devices,
+			"device": flattenInstanceConfigDeviceMap(config.Devices, diskLabelIDMap),
 
 			// TODO(displague) these can not be retrieved after the initial send
 			// "read_only":       disk.ReadOnly,
@@ -192,29 +279,22 @@ func createInstanceConfigsFromSet(client linodego.Client, instanceID int, cset [
 		}
 		// configOpts.InitRD = config["initrd"].(string)
 		// TODO(displague) need a disk_label to initrd lookup?
-		devices, ok := config["devices"].([]interface{})
+		devices, ok := config["device"].([]interface{})
 		if !ok {
 			return configIDMap, fmt.Errorf("Error converting config devices")
 		}
-		// TODO(displague) ok needed? check it
-		for _, device := range devices {
-			deviceMap, ok := device.(map[string]interface{})
-			if !ok {
-				return configIDMap, fmt.Errorf("Error converting config device %#v", device)
-			}
-			confDevices, err := expandInstanceConfigDeviceMap(deviceMap, diskIDLabelMap)
-			if err != nil {
-				return configIDMap, err
-			}
-			if confDevices != nil {
-				configOpts.Devices = *confDevices
-			}
-			// @TODO(displague) should DefaultFunc set /dev/root when no devices?
-			//if len(diskIDLabelMap) == 0 {
-			//	empty := ""
-			//	configOpts.RootDevice = &empty
-			//}
+		confDevices, err := expandInstanceConfigDeviceMap(devices, diskIDLabelMap)
+		if err != nil {
+			return configIDMap, err
+		}
+		if confDevices != nil {
+			configOpts.Devices = *confDevices
 		}
+		// @TODO(displague) should DefaultFunc set /dev/root when no devices?
+		//if len(diskIDLabelMap) == 0 {
+		//	empty := ""
+		//	configOpts.RootDevice = &empty
+		//}
 
 		//empty := ""
 		//configOpts.RootDevice = &empty
@@ -250,14 +330,14 @@ func updateInstanceConfigs(client linodego.Client, d *schema.ResourceData, insta
 		configMap[config.Label] = config
 	}
 
-	oldConfigLabels := make([]string, len(tfConfigsOld.([]interface{})))
+	oldConfigLabels := make([]string, tfConfigsOld.(*schema.Set).Len())
 
-	for _, tfConfigOld := range tfConfigsOld.([]interface{}) {
+	for _, tfConfigOld := range tfConfigsOld.(*schema.Set).List() {
 		if oldConfig, ok := tfConfigOld.(map[string]interface{}); ok {
 			oldConfigLabels = append(oldConfigLabels, oldConfig["label"].(string))
 		}
 	}
-	tfConfigs := tfConfigsNew.([]interface{})
+	tfConfigs := tfConfigsNew.(*schema.Set).List()
 	updatedConfigs = make([]*linodego.InstanceConfig, len(tfConfigs))
 	updatedConfigMap = make(map[string]int, len(tfConfigs))
 	for _, tfConfig := range tfConfigs {
@@ -286,11 +366,9 @@ func updateInstanceConfigs(client linodego.Client, d *schema.ResourceData, insta
 
 			}
 
-			tfcDevicesRaw, devicesFound := tfc["devices"]
+			tfcDevicesRaw, devicesFound := tfc["device"]
 			if tfcDevices, ok := tfcDevicesRaw.([]interface{}); devicesFound && ok {
-				devices := tfcDevices[0].(map[string]interface{})
-
-				configUpdateOpts.Devices, err = expandInstanceConfigDeviceMap(devices, diskIDLabelMap)
+				configUpdateOpts.Devices, err = expandInstanceConfigDeviceMap(tfcDevices, diskIDLabelMap)
 
 				if err != nil {
 					return rebootInstance, updatedConfigMap, updatedConfigs, err
@@ -352,7 +430,24 @@ func deleteInstanceConfigs(client linodego.Client, instanceID int, oldConfigLabe
 	return newConfigLabels, nil
 }
 
-func flattenInstanceConfigDevice(dev *linodego.InstanceConfigDevice, diskLabelIDMap map[int]string) []map[string]interface{} {
+// flattenInstanceConfigDeviceMap converts an InstanceConfigDeviceMap to a terraform device list in slot order
+func flattenInstanceConfigDeviceMap(dmap *linodego.InstanceConfigDeviceMap, diskLabelIDMap map[int]string) []map[string]interface{} {
+	devices := []map[string]interface{}{}
+	if dmap == nil {
+		return devices
+	}
+	for _, slot := range instanceConfigDeviceSlots {
+		device := flattenInstanceConfigDevice(getInstanceConfigDevice(*dmap, slot), diskLabelIDMap)
+		if device == nil {
+			continue
+		}
+		device["slot"] = slot
+		devices = append(devices, device)
+	}
+	return devices
+}
+
+func flattenInstanceConfigDevice(dev *linodego.InstanceConfigDevice, diskLabelIDMap map[int]string) map[string]interface{} {
 	if dev == nil || emptyInstanceConfigDevice(*dev) {
 		return nil
 	}
@@ -364,63 +459,100 @@ func flattenInstanceConfigDevice(dev *linodego.InstanceConfigDevice, diskLabelID
 		if label, found := diskLabelIDMap[dev.DiskID]; found {
 			ret["disk_label"] = label
 		}
-		return []map[string]interface{}{ret}
+		return ret
 	}
-	return []map[string]interface{}{{
+	return map[string]interface{}{
 		"volume_id": dev.VolumeID,
-	}}
-
+	}
 }
 
-// expandInstanceConfigDeviceMap converts a terraform linode_instance config.*.devices map to a InstanceConfigDeviceMap for the Linode API
-func expandInstanceConfigDeviceMap(m map[string]interface{}, diskIDLabelMap map[string]int) (deviceMap *linodego.InstanceConfigDeviceMap, err error) {
-	if len(m) == 0 {
+// expandInstanceConfigDeviceMap converts a terraform config.*.device list to a InstanceConfigDeviceMap for the Linode API
+func expandInstanceConfigDeviceMap(devices []interface{}, diskIDLabelMap map[string]int) (deviceMap *linodego.InstanceConfigDeviceMap, err error) {
+	if len(devices) == 0 {
 		return nil, nil
 	}
 	deviceMap = &linodego.InstanceConfigDeviceMap{}
-	for k, rdev := range m {
-		devSlots := rdev.([]interface{})
-		for _, rrdev := range devSlots {
-			dev := rrdev.(map[string]interface{})
-			tDevice := new(linodego.InstanceConfigDevice)
-			if err := assignConfigDevice(tDevice, dev, diskIDLabelMap); err != nil {
-				return nil, err
-			}
+	assigned := make(map[string]bool, len(devices))
+	for _, rdev := range devices {
+		dev, ok := rdev.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("Error converting config device %#v", rdev)
+		}
+		slot := dev["slot"].(string)
+		if _, found := instanceConfigDeviceFields[slot]; !found {
+			return nil, fmt.Errorf("Error mapping config device: unknown device slot %s", slot)
+		}
+		if assigned[slot] {
+			return nil, fmt.Errorf("Error mapping config device: device slot %s is assigned more than once", slot)
+		}
+		assigned[slot] = true
 
-			*deviceMap = changeInstanceConfigDevice(*deviceMap, k, tDevice)
+		tDevice := new(linodego.InstanceConfigDevice)
+		if err := assignConfigDevice(tDevice, dev, diskIDLabelMap); err != nil {
+			return nil, err
 		}
+
+		*deviceMap = changeInstanceConfigDevice(*deviceMap, slot, tDevice)
 	}
 	return deviceMap, nil
 }
 
+// validateInstanceConfigDeviceOrder checks that a config.*.device list is in slot order, which is the order it is read back in
+func validateInstanceConfigDeviceOrder(devices []interface{}) error {
+	last := -1
+	for _, rdev := range devices {
+		dev, ok := rdev.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		slot, _ := dev["slot"].(string)
+		for index, knownSlot := range instanceConfigDeviceSlots {
+			if knownSlot != slot {
+				continue
+			}
+			if index == last {
+				return fmt.Errorf("Error mapping config device: device slot %s is assigned more than once", slot)
+			}
+			if index < last {
+				return fmt.Errorf("Error mapping config device: device slot %s must be listed before slot %s, devices are listed in slot order", slot, instanceConfigDeviceSlots[last])
+			}
+			last = index
+		}
+	}
+	return nil
+}
+
 // changeInstanceConfigDevice returns a copy of a config device map with the specified disk slot changed to the provided device
 func changeInstanceConfigDevice(deviceMap linodego.InstanceConfigDeviceMap, namedSlot string, device *linodego.InstanceConfigDevice) linodego.InstanceConfigDeviceMap {
 	tDevice := device
 	if tDevice != nil && emptyInstanceConfigDevice(*tDevice) {
 		tDevice = nil
 	}
-	switch namedSlot {
-	case "sda":
-		deviceMap.SDA = tDevice
-	case "sdb":
-		deviceMap.SDB = tDevice
-	case "sdc":
-		deviceMap.SDC = tDevice
-	case "sdd":
-		deviceMap.SDD = tDevice
-	case "sde":
-		deviceMap.SDE = tDevice
-	case "sdf":
-		deviceMap.SDF = tDevice
-	case "sdg":
-		deviceMap.SDG = tDevice
-	case "sdh":
-		deviceMap.SDH = tDevice
+	if field, found := instanceConfigDeviceFields[namedSlot]; found {
+		reflect.ValueOf(&deviceMap).Elem().Field(field).Set(reflect.ValueOf(tDevice))
 	}
 
 	return deviceMap
 }
 
+// getInstanceConfigDevice returns the device assigned to the named slot of a config device map
+func getInstanceConfigDevice(deviceMap linodego.InstanceConfigDeviceMap, namedSlot string) *linodego.InstanceConfigDevice {
+	field, found := instanceConfigDeviceFields[namedSlot]
+	if !found {
+		return nil
+	}
+	return reflect.ValueOf(deviceMap).Field(field).Interface().(*linodego.InstanceConfigDevice)
+}
+
+// listInstanceConfigDevices returns the devices of every slot of a config device map in slot order
+func listInstanceConfigDevices(dmap linodego.InstanceConfigDeviceMap) []*linodego.InstanceConfigDevice {
+	drives := make([]*linodego.InstanceConfigDevice, 0, len(instanceConfigDeviceSlots))
+	for _, slot := range instanceConfigDeviceSlots {
+		drives = append(drives, getInstanceConfigDevice(dmap, slot))
+	}
+	return drives
+}
+
 // emptyInstanceConfigDevice returns true only when neither the disk or volume have been assigned to a config device
 func emptyInstanceConfigDevice(dev linodego.InstanceConfigDevice) bool {
 	return (dev.DiskID == 0 && dev.VolumeID == 0)
@@ -428,9 +560,7 @@ func emptyInstanceConfigDevice(dev linodego.InstanceConfigDevice) bool {
 
 // emptyConfigDeviceMap returns true only when none of the disks in a config device map have been assigned
 func emptyConfigDeviceMap(dmap linodego.InstanceConfigDeviceMap) bool {
-	drives := []*linodego.InstanceConfigDevice{
-		dmap.SDA, dmap.SDB, dmap.SDC, dmap.SDD, dmap.SDE, dmap.SDF, dmap.SDG, dmap.SDH,
-	}
+	drives := listInstanceConfigDevices(dmap)
 	empty := true
 	for _, drive := range drives {
 		if drive != nil && !emptyInstanceConfigDevice(*drive) {
@@ -560,14 +690,14 @@ func updateInstanceDisks(client linodego.Client, d *schema.ResourceData, instanc
 		diskMap[disk.Label] = disk
 	}
 
-	oldDiskLabels := make([]string, len(tfDisksOld.([]interface{})))
+	oldDiskLabels := make([]string, tfDisksOld.(*schema.Set).Len())
 
-	for _, tfDiskOld := range tfDisksOld.([]interface{}) {
+	for _, tfDiskOld := range tfDisksOld.(*schema.Set).List() {
 		if oldDisk, ok := tfDiskOld.(map[string]interface{}); ok {
 			oldDiskLabels = append(oldDiskLabels, oldDisk["label"].(string))
 		}
 	}
-	tfDisks := tfDisksNew.([]interface{})
+	tfDisks := tfDisksNew.(*schema.Set).List()
 
 	//updatedDisks := make([]*linodego.InstanceDisk, tfDisks.Len())
 	diskIDLabelMap = make(map[string]int, len(tfDisks))
@@ -687,8 +817,56 @@ func createRandomRootPassword() (string, error) {
 	return rootPass, nil
 }
 
-// changeInstanceType resizes the Linode Instance
-func changeInstanceType(client *linodego.Client, instance *linodego.Instance, targetType string, d *schema.ResourceData) error {
+// instanceTypeDiskDelta checks that the disks of the Linode Instance fit the target type before it is resized. It returns
+// the size change of the largest disk, which follows the change in disk space of the type when resizeDisk is set.
+// The API does not report the space used by the filesystem on a disk, so a shrink is only checked against the disk size.
+func instanceTypeDiskDelta(client *linodego.Client, linodeID int, currentType string, targetType string, resizeDisk bool) (diskDelta int, err error) {
+	target, err := client.GetType(context.Background(), targetType)
+	if err != nil {
+		return 0, fmt.Errorf("Error fetching Linode type %s: %s", targetType, err)
+	}
+
+	totalDiskSize, err := getTotalDiskSize(client, linodeID)
+	if err != nil {
+		return 0, fmt.Errorf("Error fetching the disks of Linode Instance %d: %s", linodeID, err)
+	}
+
+	if resizeDisk {
+		current, err := client.GetType(context.Background(), currentType)
+		if err != nil {
+			return 0, fmt.Errorf("Error fetching Linode type %s: %s", currentType, err)
+		}
+		diskDelta = target.Disk - current.Disk
+
+		if diskDelta < 0 {
+			_, biggestDiskSize, err := getBiggestDisk(client, linodeID)
+			if err != nil {
+				return 0, fmt.Errorf("Error fetching the disks of Linode Instance %d: %s", linodeID, err)
+			}
+			if biggestDiskSize+diskDelta <= 0 {
+				return 0, fmt.Errorf("Error resizing Linode Instance %d to %s: its largest disk (%d MB) can not be shrunk by %d MB", linodeID, targetType, biggestDiskSize, -diskDelta)
+			}
+		}
+	}
+
+	if totalDiskSize+diskDelta > target.Disk {
+		hint := "shrink or remove its disks first"
+		if !resizeDisk {
+			hint += ", or set resize_disk to shrink its largest disk"
+		}
+		return 0, fmt.Errorf("Error resizing Linode Instance %d to %s: its disks use %d MB but %s only has %d MB; %s", linodeID, targetType, totalDiskSize+diskDelta, targetType, target.Disk, hint)
+	}
+
+	return diskDelta, nil
+}
+
+// changeInstanceType resizes the Linode Instance, growing or shrinking its largest disk along with it when resizeDisk is set
+func changeInstanceType(client *linodego.Client, instance *linodego.Instance, targetType string, resizeDisk bool, d *schema.ResourceData) error {
+	diskDelta, err := instanceTypeDiskDelta(client, instance.ID, instance.Type, targetType, resizeDisk)
+	if err != nil {
+		return err
+	}
+
 	// Instance must be either offline or running (with no extra activity) to resize.
 	if instance.Status == linodego.InstanceOffline || instance.Status == linodego.InstanceShuttingDown {
 		if _, err := client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
@@ -700,25 +878,299 @@ func changeInstanceType(client *linodego.Client, instance *linodego.Instance, ta
 		}
 	}
 
+	var biggestDisk *linodego.InstanceDisk
+	if diskDelta != 0 {
+		biggestDiskID, _, err := getBiggestDisk(client, instance.ID)
+		if err != nil {
+			return fmt.Errorf("Error fetching the disks of instance %d: %s", instance.ID, err)
+		}
+		if biggestDisk, err = client.GetInstanceDisk(context.Background(), instance.ID, biggestDiskID); err != nil {
+			return fmt.Errorf("Error fetching instance %d disk %d: %s", instance.ID, biggestDiskID, err)
+		}
+	}
+
+	// The largest disk has to shrink before the instance can be downsized, which the API only allows while it is powered off
+	shutdownToShrink := diskDelta < 0 && isInstanceBooted(instance)
+	if shutdownToShrink {
+		if err := changeInstanceBootState(*client, instance, false, 0, d); err != nil {
+			return err
+		}
+	}
+	if diskDelta < 0 {
+		if _, err := client.WaitForInstanceDiskStatus(context.Background(), instance.ID, biggestDisk.ID, linodego.DiskReady, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("Error waiting for Instance %d Disk %d to be ready: %s", instance.ID, biggestDisk.ID, err)
+		}
+
+		// allow for clock skew between the API and Terraform when looking for the disk resize event
+		minStart := time.Now().Add(-time.Minute)
+		if err := client.ResizeInstanceDisk(context.Background(), instance.ID, biggestDisk.ID, biggestDisk.Size+diskDelta); err != nil {
+			return fmt.Errorf("Error shrinking instance %d disk %d: %s", instance.ID, biggestDisk.ID, err)
+		}
+		if _, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionDiskResize, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("Error waiting for resize of Instance %d Disk %d: %s", instance.ID, biggestDisk.ID, err)
+		}
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the resize event
+	minStart := time.Now().Add(-time.Minute)
 	if err := client.ResizeInstance(context.Background(), instance.ID, targetType); err != nil {
 		return fmt.Errorf("Error resizing instance %d: %s", instance.ID, err)
 	}
 
-	_, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeResize, *instance.Created, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
+	_, err = client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeResize, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 	if err != nil {
 		return fmt.Errorf("Error waiting for instance %d to finish resizing: %s", instance.ID, err)
 	}
 
+	// an instance shut down to shrink its disk is powered back on once it has been downsized
+	if shutdownToShrink {
+		if err := changeInstanceBootState(*client, instance, true, 0, d); err != nil {
+			return err
+		}
+	}
+
+	// The largest disk can only grow once the instance has been upsized
+	if diskDelta > 0 {
+		resized, err := client.GetInstance(context.Background(), instance.ID)
+		if err != nil {
+			return fmt.Errorf("Error fetching data about the resized instance %d: %s", instance.ID, err)
+		}
+		if err := changeInstanceDiskSize(client, *resized, *biggestDisk, biggestDisk.Size+diskDelta, d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// instanceAddressKeys are the linode_instance attributes that report the addresses of the Linode Instance
+var instanceAddressKeys = []string{
+	"ip_address", "private_ip_address", "ipv4", "ipv6",
+	"ipv4_public", "ipv4_private", "ipv4_shared", "ipv4_reserved",
+	"ipv6_slaac", "ipv6_link_local", "ipv6_ranges",
+}
+
+// migrateInstanceRegion migrates the Linode Instance to another region, returning it to its power state once it arrives
+func migrateInstanceRegion(client linodego.Client, instance *linodego.Instance, targetRegion string, d *schema.ResourceData) error {
+	targetStatus := linodego.InstanceOffline
+	if isInstanceBooted(instance) {
+		targetStatus = linodego.InstanceRunning
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the migration event
+	minStart := time.Now().Add(-time.Minute)
+	if err := client.MigrateInstanceWithOptions(context.Background(), instance.ID, linodego.InstanceMigrateOptions{Region: targetRegion}); err != nil {
+		return fmt.Errorf("Error migrating Linode Instance %d from %s to %s: %s", instance.ID, instance.Region, targetRegion, err)
+	}
+
+	if _, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeMigrate, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d to finish migrating to %s: %s", instance.ID, targetRegion, err)
+	}
+
+	if _, err := client.WaitForInstanceStatus(context.Background(), instance.ID, targetStatus, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for migrated Linode Instance %d to be %s: %s", instance.ID, targetStatus, err)
+	}
+	return nil
+}
+
+// cloneInstance creates a Linode Instance by cloning the disks and configs of the clone_from instance
+func cloneInstance(client linodego.Client, createOpts linodego.InstanceCreateOptions, d *schema.ResourceData) (*linodego.Instance, error) {
+	sourceID := d.Get("clone_from.0.linode_id").(int)
+	cloneOpts := linodego.InstanceCloneOptions{
+		Region:         createOpts.Region,
+		Type:           createOpts.Type,
+		Label:          createOpts.Label,
+		Group:          createOpts.Group,
+		BackupsEnabled: createOpts.BackupsEnabled,
+		Disks:          expandIntSet(d.Get("clone_from.0.disks")),
+		Configs:        expandIntSet(d.Get("clone_from.0.configs")),
+	}
+
+	instance, err := client.CloneInstance(context.Background(), sourceID, cloneOpts)
+	if err != nil {
+		return nil, fmt.Errorf("Error cloning Linode instance %d: %s", sourceID, err)
+	}
+
+	// The clone event belongs to the source instance
+	if _, err = client.WaitForEventFinished(context.Background(), sourceID, linodego.EntityLinode, linodego.ActionLinodeClone, *instance.Created, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return nil, fmt.Errorf("Error waiting for Linode instance %d to finish cloning to Linode instance %d: %s", sourceID, instance.ID, err)
+	}
+
+	if _, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return nil, fmt.Errorf("Timed-out waiting for cloned Linode instance %d to be ready: %s", instance.ID, err)
+	}
+
+	if len(createOpts.Tags) > 0 {
+		if _, err = client.UpdateInstance(context.Background(), instance.ID, linodego.InstanceUpdateOptions{Tags: &createOpts.Tags}); err != nil {
+			return nil, fmt.Errorf("Error tagging cloned Linode instance %d: %s", instance.ID, err)
+		}
+	}
+
+	if createOpts.PrivateIP {
+		if _, err = client.AddInstanceIPAddress(context.Background(), instance.ID, false); err != nil {
+			return nil, fmt.Errorf("Error activating private networking on cloned Linode instance %d: %s", instance.ID, err)
+		}
+	}
+
+	return instance, nil
+}
+
+// instanceRebuildKeys are the linode_instance attributes that require a rebuild, or a new instance, when changed
+var instanceRebuildKeys = []string{"image", "authorized_keys", "root_pass", "stackscript_id", "stackscript_data"}
+
+// hasInstanceRebuildChange reports whether any of the attributes deployed by a rebuild have changed
+func hasInstanceRebuildChange(d *schema.ResourceData) bool {
+	for _, key := range instanceRebuildKeys {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// rebuildInstance redeploys the configured image to the Linode Instance, replacing all of its disks and configs
+func rebuildInstance(client linodego.Client, instance *linodego.Instance, d *schema.ResourceData) error {
+	rebuildOpts := linodego.RebuildInstanceOptions{
+		Image:         d.Get("image").(string),
+		RootPass:      d.Get("root_pass").(string),
+		StackscriptID: d.Get("stackscript_id").(int),
+		Booted:        d.Get("booted").(bool),
+	}
+
+	for _, key := range d.Get("authorized_keys").([]interface{}) {
+		rebuildOpts.AuthorizedKeys = append(rebuildOpts.AuthorizedKeys, key.(string))
+	}
+
+	if rebuildOpts.RootPass == "" {
+		var err error
+		if rebuildOpts.RootPass, err = createRandomRootPassword(); err != nil {
+			return err
+		}
+	}
+
+	if stackscriptData, ok := d.Get("stackscript_data").(map[string]interface{}); ok && len(stackscriptData) > 0 {
+		rebuildOpts.StackscriptData = make(map[string]string, len(stackscriptData))
+		for name, value := range stackscriptData {
+			rebuildOpts.StackscriptData[name] = value.(string)
+		}
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the rebuild event
+	minStart := time.Now().Add(-time.Minute)
+	if _, err := client.RebuildInstance(context.Background(), instance.ID, rebuildOpts); err != nil {
+		return fmt.Errorf("Error rebuilding Linode instance %d: %s", instance.ID, err)
+	}
+
+	if _, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeRebuild, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode instance %d to finish rebuilding: %s", instance.ID, err)
+	}
+
+	status := linodego.InstanceOffline
+	if rebuildOpts.Booted {
+		status = linodego.InstanceRunning
+	}
+	if _, err := client.WaitForInstanceStatus(context.Background(), instance.ID, status, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode instance %d to be %s after rebuilding: %s", instance.ID, status, err)
+	}
+
 	return nil
 }
 
+// instanceRebootPolicies are the values of the linode_instance reboot_policy
+var instanceRebootPolicies = []string{"auto", "never", "on_change_of"}
+
+// instanceRebootReasons are the linode_instance changes that only take effect once the Linode Instance is rebooted
+var instanceRebootReasons = []string{"disk", "config", "private_ip"}
+
+// instanceRebootAllowed reports whether the reboot_policy permits rebooting the Linode Instance for any of the changes
+func instanceRebootAllowed(policy string, onChangeOf *schema.Set, reasons []string) bool {
+	switch policy {
+	case "never":
+		return false
+	case "on_change_of":
+		for _, reason := range reasons {
+			if onChangeOf.Contains(reason) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// plannedInstanceRebootReasons returns the planned changes that will only take effect once the Linode Instance is rebooted
+func plannedInstanceRebootReasons(d *schema.ResourceDiff) []string {
+	var reasons []string
+	if d.HasChange("disk") {
+		reasons = append(reasons, "disk")
+	}
+	if d.HasChange("private_ip") && d.Get("private_ip").(bool) {
+		reasons = append(reasons, "private_ip")
+	}
+	return reasons
+}
+
+// isInstanceBooted reports whether the Linode Instance is powered on or in the process of powering on
+func isInstanceBooted(instance *linodego.Instance) bool {
+	switch instance.Status {
+	case linodego.InstanceRunning, linodego.InstanceBooting, linodego.InstanceRebooting:
+		return true
+	}
+	return false
+}
+
+// changeInstanceBootState boots or shuts down the Linode Instance and waits for it to reach the matching status
+func changeInstanceBootState(client linodego.Client, instance *linodego.Instance, booted bool, bootConfig int, d *schema.ResourceData) error {
+	if booted {
+		if err := client.BootInstance(context.Background(), instance.ID, bootConfig); err != nil {
+			return fmt.Errorf("Error booting Linode instance %d: %s", instance.ID, err)
+		}
+		if _, err := client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceRunning, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("Timed-out waiting for Linode instance %d to boot: %s", instance.ID, err)
+		}
+		return nil
+	}
+
+	if err := client.ShutdownInstance(context.Background(), instance.ID); err != nil {
+		return fmt.Errorf("Error shutting down Linode instance %d: %s", instance.ID, err)
+	}
+	if _, err := client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode instance %d to shut down: %s", instance.ID, err)
+	}
+	return nil
+}
+
+// shutdownInstanceBeforeDestroy gracefully shuts down a running Instance so that it can be deleted.
+// Failures are logged rather than returned, the Instance is deleted regardless.
+func shutdownInstanceBeforeDestroy(client linodego.Client, id int, timeoutSeconds int) {
+	instance, err := client.GetInstance(context.Background(), id)
+	if err != nil {
+		log.Printf("[WARN] Unable to fetch Linode Instance %d before shutting it down: %s", id, err)
+		return
+	}
+	if instance.Status == linodego.InstanceOffline {
+		return
+	}
+
+	log.Printf("[INFO] Shutting down Linode Instance %d before deleting it", id)
+	if err := client.ShutdownInstance(context.Background(), id); err != nil {
+		log.Printf("[WARN] Error shutting down Linode Instance %d, deleting it anyway: %s", id, err)
+		return
+	}
+	if _, err := client.WaitForInstanceStatus(context.Background(), id, linodego.InstanceOffline, timeoutSeconds); err != nil {
+		log.Printf("[WARN] Timed-out waiting for Linode Instance %d to shut down, deleting it anyway: %s", id, err)
+	}
+}
+
 func changeInstanceDiskSize(client *linodego.Client, instance linodego.Instance, disk linodego.InstanceDisk, targetSize int, d *schema.ResourceData) error {
-	if instance.Specs.Disk > targetSize {
-		client.ResizeInstanceDisk(context.Background(), instance.ID, disk.ID, targetSize)
+	if instance.Specs.Disk >= targetSize {
+		minStart := time.Now().Add(-time.Minute)
+		if err := client.ResizeInstanceDisk(context.Background(), instance.ID, disk.ID, targetSize); err != nil {
+			return fmt.Errorf("Error resizing Instance %d Disk %d: %s", instance.ID, disk.ID, err)
+		}
 
 		// Wait for the Disk Resize Operation to Complete
-		// waitForEventComplete(client, instance.ID, "linode_resize", waitMinutes)
-		_, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionDiskResize, disk.Updated, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
+		_, err := client.WaitForEventFinished(context.Background(), instance.ID, linodego.EntityLinode, linodego.ActionDiskResize, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 		if err != nil {
 			return fmt.Errorf("Error waiting for resize of Instance %d Disk %d: %s", instance.ID, disk.ID, err)
 		}
@@ -829,10 +1281,7 @@ func assignConfigDevice(device *linodego.InstanceConfigDevice, dev map[string]in
 
 // detachConfigVolumes detaches any volumes associated with an InstanceConfig.Devices struct
 func detachConfigVolumes(dmap linodego.InstanceConfigDeviceMap, detacher volumeDetacher) error {
-	// Preallocate our slice of config devices
-	drives := []*linodego.InstanceConfigDevice{
-		dmap.SDA, dmap.SDB, dmap.SDC, dmap.SDD, dmap.SDE, dmap.SDF, dmap.SDG, dmap.SDH,
-	}
+	drives := listInstanceConfigDevices(dmap)
 
 	// Make a buffered error channel for our goroutines to send error values back on
 	errCh := make(chan error, len(drives))
@@ -0,0 +1,169 @@
+package linode
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// tagsSchema returns the schema used by every resource that accepts Linode tags
+func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         schema.HashString,
+		Description: "An array of tags applied to this object. Tags are for organizational purposes only.",
+		Optional:    true,
+	}
+}
+
+// tagsAllSchema returns the schema of the tags applied to an object, including the provider default_tags
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Set:         schema.HashString,
+		Description: "All tags applied to this object, including the provider default_tags. Tags matched by the provider ignore_tags are left out unless they are configured on this object.",
+		Computed:    true,
+	}
+}
+
+// expandStringSet converts a set of strings to a sorted slice.
+// An empty, non-nil slice is returned so updates can clear all values.
+func expandStringSet(v interface{}) []string {
+	values := []string{}
+	if set, ok := v.(*schema.Set); ok && set != nil {
+		for _, value := range set.List() {
+			values = append(values, value.(string))
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// expandTags returns the sorted tags to send to the API for a resource: the tags configured
+// on the resource, the provider default_tags, and any of the current tags that the resource
+// did not configure before, so that updates don't remove tags applied outside of the resource,
+// such as by a linode_tag or matched by the provider ignore_tags.
+func expandTags(d *schema.ResourceData, meta interface{}, current []string) []string {
+	providerMeta := meta.(*ProviderMeta)
+
+	oldTags, newTags := d.GetChange("tags")
+	previous := expandStringSet(oldTags)
+
+	tags := expandStringSet(newTags)
+	tags = append(tags, providerMeta.DefaultTags...)
+	for _, tag := range current {
+		if stringInSlice(tag, providerMeta.IgnoreTags) || !stringInSlice(tag, previous) {
+			tags = append(tags, tag)
+		}
+	}
+	return uniqueSortedStrings(tags)
+}
+
+// flattenTags returns the tags of an object as they should be stored in state. Only the tags
+// configured on the resource are kept, so tags applied outside of the resource, such as by a
+// linode_tag, are not reported as a change.
+func flattenTags(d *schema.ResourceData, meta interface{}, tags []string) []string {
+	configured := expandStringSet(d.Get("tags"))
+
+	flattened := []string{}
+	for _, tag := range tags {
+		if stringInSlice(tag, configured) {
+			flattened = append(flattened, tag)
+		}
+	}
+	return uniqueSortedStrings(flattened)
+}
+
+// flattenTagsAll returns all tags of an object as they should be stored in tags_all.
+// Tags matched by the provider ignore_tags are left out unless they are configured on the resource.
+func flattenTagsAll(d *schema.ResourceData, meta interface{}, tags []string) []string {
+	providerMeta := meta.(*ProviderMeta)
+	configured := expandStringSet(d.Get("tags"))
+
+	flattened := []string{}
+	for _, tag := range tags {
+		if stringInSlice(tag, configured) || !stringInSlice(tag, providerMeta.IgnoreTags) {
+			flattened = append(flattened, tag)
+		}
+	}
+	return uniqueSortedStrings(flattened)
+}
+
+// customizeDiffTags plans tags_all as the configured tags, the provider default_tags and the tags applied
+// outside of the resource, so that new default_tags and default tags removed outside of Terraform are
+// shown as a change.
+func customizeDiffTags(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	planned := append(expandStringSet(d.Get("tags")), meta.(*ProviderMeta).DefaultTags...)
+	if d.Id() != "" {
+		oldTags, _ := d.GetChange("tags")
+		previous := expandStringSet(oldTags)
+		for _, tag := range expandStringSet(d.Get("tags_all")) {
+			if !stringInSlice(tag, previous) {
+				planned = append(planned, tag)
+			}
+		}
+	}
+	planned = uniqueSortedStrings(planned)
+
+	if d.Id() != "" && reflect.DeepEqual(planned, expandStringSet(d.Get("tags_all"))) {
+		return nil
+	}
+	return d.SetNew("tags_all", planned)
+}
+
+// importStateWithTags returns an importer that reads the object and reports all of its tags, except for
+// the provider default_tags, in tags, as nothing is known about which of them the resource should manage
+func importStateWithTags(read schema.ReadFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id := d.Id()
+		if err := read(d, meta); err != nil {
+			return nil, err
+		}
+		if d.Id() == "" {
+			return nil, fmt.Errorf("Error importing %s: the object does not exist", id)
+		}
+
+		tags := []string{}
+		for _, tag := range expandStringSet(d.Get("tags_all")) {
+			if !stringInSlice(tag, meta.(*ProviderMeta).DefaultTags) {
+				tags = append(tags, tag)
+			}
+		}
+		d.Set("tags", tags)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// hasTagsChange reports whether the configured tags or the planned tags_all of an object have changed
+func hasTagsChange(d *schema.ResourceData) bool {
+	return d.HasChange("tags") || d.HasChange("tags_all")
+}
+
+func stringInSlice(s string, slice []string) bool {
+	for _, v := range slice {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func uniqueSortedStrings(values []string) []string {
+	sort.Strings(values)
+	unique := make([]string, 0, len(values))
+	for i, v := range values {
+		if i == 0 || values[i-1] != v {
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
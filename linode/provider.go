@@ -13,6 +13,13 @@ import (
 	"golang.org/x/oauth2"
 )
 
+// ProviderMeta is passed to every resource and data source as their meta value
+type ProviderMeta struct {
+	Client      linodego.Client
+	DefaultTags []string
+	IgnoreTags  []string
+}
+
 // Provider creates and manages the resources in a Linode configuration.
 func Provider() terraform.ResourceProvider {
 	return &schema.Provider{
@@ -23,26 +30,55 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("LINODE_TOKEN", nil),
 				Description: "The token that allows you access to your Linode account",
 			},
+			"default_tags": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Tags applied to every taggable resource managed by this provider, in addition to the tags configured on the resource.",
+			},
+			"ignore_tags": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "Tags applied outside of Terraform that should not be reported as changes to taggable resources.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"linode_instance_type": dataSourceLinodeInstanceType(),
-			"linode_region":        dataSourceLinodeRegion(),
-			"linode_image":         dataSourceLinodeImage(),
-			"linode_sshkey":        dataSourceLinodeSSHKey(),
+			"linode_instance_backups": dataSourceLinodeInstanceBackups(),
+			"linode_instance_type":    dataSourceLinodeInstanceType(),
+			"linode_ipv6_pools":       dataSourceLinodeIPv6Pools(),
+			"linode_ipv6_ranges":      dataSourceLinodeIPv6Ranges(),
+			"linode_region":           dataSourceLinodeRegion(),
+			"linode_image":            dataSourceLinodeImage(),
+			"linode_sshkey":           dataSourceLinodeSSHKey(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"linode_image":               resourceLinodeImage(),
-			"linode_instance":            resourceLinodeInstance(),
-			"linode_domain":              resourceLinodeDomain(),
-			"linode_domain_record":       resourceLinodeDomainRecord(),
-			"linode_nodebalancer":        resourceLinodeNodeBalancer(),
-			"linode_nodebalancer_config": resourceLinodeNodeBalancerConfig(),
-			"linode_nodebalancer_node":   resourceLinodeNodeBalancerNode(),
-			"linode_volume":              resourceLinodeVolume(),
-			"linode_sshkey":              resourceLinodeSSHKey(),
-			"linode_stackscript":         resourceLinodeStackscript(),
+			"linode_image":                   resourceLinodeImage(),
+			"linode_instance":                resourceLinodeInstance(),
+			"linode_instance_backup_restore": resourceLinodeInstanceBackupRestore(),
+			"linode_instance_config":         resourceLinodeInstanceConfig(),
+			"linode_instance_disk":           resourceLinodeInstanceDisk(),
+			"linode_instance_ip":             resourceLinodeInstanceIP(),
+			"linode_instance_maintenance":    resourceLinodeInstanceMaintenance(),
+			"linode_instance_rescue":         resourceLinodeInstanceRescue(),
+			"linode_instance_shared_ips":     resourceLinodeInstanceSharedIPs(),
+			"linode_instance_snapshot":       resourceLinodeInstanceSnapshot(),
+			"linode_ip_assignment":           resourceLinodeIPAssignment(),
+			"linode_ipv6_range":              resourceLinodeIPv6Range(),
+			"linode_domain":                  resourceLinodeDomain(),
+			"linode_domain_record":           resourceLinodeDomainRecord(),
+			"linode_nodebalancer":            resourceLinodeNodeBalancer(),
+			"linode_nodebalancer_config":     resourceLinodeNodeBalancerConfig(),
+			"linode_nodebalancer_node":       resourceLinodeNodeBalancerNode(),
+			"linode_rdns":                    resourceLinodeRDNS(),
+			"linode_volume":                  resourceLinodeVolume(),
+			"linode_sshkey":                  resourceLinodeSSHKey(),
+			"linode_stackscript":             resourceLinodeStackscript(),
+			"linode_tag":                     resourceLinodeTag(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -78,5 +114,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("Error connecting to the Linode API: %s", err)
 	}
 
-	return client, nil
+	return &ProviderMeta{
+		Client:      client,
+		DefaultTags: expandStringSet(d.Get("default_tags")),
+		IgnoreTags:  expandStringSet(d.Get("ignore_tags")),
+	}, nil
 }
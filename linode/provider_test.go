@@ -1,9 +1,13 @@
 package linode
 
 import (
+	"fmt"
 	"os"
+	"reflect"
+	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -29,3 +33,121 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("LINODE_TOKEN must be set for acceptance tests")
 	}
 }
+
+func TestProviderTags(t *testing.T) {
+	meta := &ProviderMeta{
+		DefaultTags: []string{"tf_default"},
+		IgnoreTags:  []string{"billing"},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": []interface{}{"tf_test"},
+	})
+
+	if tags := expandTags(d, meta, nil); !reflect.DeepEqual(tags, []string{"tf_default", "tf_test"}) {
+		t.Errorf("expected configured and default tags on create, got %v", tags)
+	}
+
+	if tags := expandTags(d, meta, []string{"billing", "production"}); !reflect.DeepEqual(tags, []string{"billing", "production", "tf_default", "tf_test"}) {
+		t.Errorf("expected tags applied outside of the resource to be preserved on update, got %v", tags)
+	}
+
+	if tags := flattenTags(d, meta, []string{"billing", "tf_default", "tf_test", "production"}); !reflect.DeepEqual(tags, []string{"tf_test"}) {
+		t.Errorf("expected only configured tags to be kept in state, got %v", tags)
+	}
+
+	if tags := flattenTagsAll(d, meta, []string{"billing", "tf_default", "tf_test"}); !reflect.DeepEqual(tags, []string{"tf_default", "tf_test"}) {
+		t.Errorf("expected only ignored tags to be left out of tags_all, got %v", tags)
+	}
+}
+
+func TestProviderTagsDiff(t *testing.T) {
+	meta := &ProviderMeta{DefaultTags: []string{"tf_default"}}
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"label":  "tf_test",
+		"region": "us-west",
+		"tags":   []interface{}{"tf_test"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating config: %s", err)
+	}
+
+	state := func(tagsAll ...string) *terraform.InstanceState {
+		attributes := map[string]string{
+			"label":  "tf_test",
+			"region": "us-west",
+			"tags.#": "1",
+			fmt.Sprintf("tags.%d", schema.HashString("tf_test")): "tf_test",
+			"tags_all.#": strconv.Itoa(len(tagsAll)),
+		}
+		for _, tag := range tagsAll {
+			attributes[fmt.Sprintf("tags_all.%d", schema.HashString(tag))] = tag
+		}
+		return &terraform.InstanceState{ID: "1234", Attributes: attributes}
+	}
+
+	diff, err := resourceLinodeVolume().Diff(state("tf_test", "tf_default"), terraform.NewResourceConfig(raw), meta)
+	if err != nil {
+		t.Fatalf("Error planning: %s", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected no change when the default tags are applied, got %v", diff.Attributes)
+	}
+
+	// a default tag removed outside of Terraform, or added to the provider, is planned
+	diff, err = resourceLinodeVolume().Diff(state("tf_test"), terraform.NewResourceConfig(raw), meta)
+	if err != nil {
+		t.Fatalf("Error planning: %s", err)
+	}
+	if diff.Empty() || diff.Attributes["tags_all.#"] == nil || diff.Attributes["tags_all.#"].New != "2" {
+		t.Errorf("expected the missing default tag to be planned in tags_all, got %v", diff)
+	}
+
+	// a tag applied outside of the resource, such as by a linode_tag, is not a change
+	diff, err = resourceLinodeVolume().Diff(state("tf_test", "tf_default", "production"), terraform.NewResourceConfig(raw), meta)
+	if err != nil {
+		t.Fatalf("Error planning: %s", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected no change for a tag applied outside of the resource, got %v", diff.Attributes)
+	}
+}
+
+func TestProviderTagsRemoved(t *testing.T) {
+	meta := &ProviderMeta{DefaultTags: []string{"tf_default"}}
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"label":  "tf_test",
+		"region": "us-west",
+		"tags":   []interface{}{"tf_test"},
+	})
+	if err != nil {
+		t.Fatalf("Error creating config: %s", err)
+	}
+
+	state := &terraform.InstanceState{ID: "1234", Attributes: map[string]string{
+		"label":  "tf_test",
+		"region": "us-west",
+		"tags.#": "2",
+		fmt.Sprintf("tags.%d", schema.HashString("tf_test")):  "tf_test",
+		fmt.Sprintf("tags.%d", schema.HashString("tf_stale")): "tf_stale",
+	}}
+
+	var tags []string
+	resource := resourceLinodeVolume()
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+		tags = expandTags(d, meta, []string{"production", "tf_stale", "tf_test"})
+		return nil
+	}
+
+	diff, err := resource.Diff(state, terraform.NewResourceConfig(raw), meta)
+	if err != nil {
+		t.Fatalf("Error planning: %s", err)
+	}
+	if _, err = resource.Apply(state, diff, meta); err != nil {
+		t.Fatalf("Error applying: %s", err)
+	}
+
+	// only the tag removed from the resource is removed, the tag applied by a linode_tag is kept
+	if !reflect.DeepEqual(tags, []string{"production", "tf_default", "tf_test"}) {
+		t.Errorf("expected the removed tag to be removed and other tags preserved, got %v", tags)
+	}
+}
@@ -20,8 +20,11 @@ func resourceLinodeDomain() *schema.Resource {
 		Update: resourceLinodeDomainUpdate,
 		Delete: resourceLinodeDomainDelete,
 		Exists: resourceLinodeDomainExists,
+
+		CustomizeDiff: customizeDiffTags,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateWithTags(resourceLinodeDomainRead),
 		},
 		Schema: map[string]*schema.Schema{
 			"domain": &schema.Schema{
@@ -101,6 +104,8 @@ func resourceLinodeDomain() *schema.Resource {
 				Description: "Start of Authority email address. This is required for master Domains.",
 				Optional:    true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -132,7 +137,7 @@ func domainSecondsValidator() schema.SchemaValidateFunc {
 }
 
 func resourceLinodeDomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return false, fmt.Errorf("Error parsing Linode Domain ID %s as int: %s", d.Id(), err)
@@ -150,7 +155,7 @@ func resourceLinodeDomainExists(d *schema.ResourceData, meta interface{}) (bool,
 }
 
 func resourceLinodeDomainRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Domain ID %s as int: %s", d.Id(), err)
@@ -185,15 +190,18 @@ func resourceLinodeDomainRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("expire_sec", domain.ExpireSec)
 	d.Set("refresh_sec", domain.RefreshSec)
 	d.Set("soa_email", domain.SOAEmail)
+	d.Set("tags", flattenTags(d, meta, domain.Tags))
+	d.Set("tags_all", flattenTagsAll(d, meta, domain.Tags))
 
 	return nil
 }
 
 func resourceLinodeDomainCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode Domain")
 	}
+	client := providerMeta.Client
 
 	createOpts := linodego.DomainCreateOptions{
 		Domain:      d.Get("domain").(string),
@@ -205,6 +213,7 @@ func resourceLinodeDomainCreate(d *schema.ResourceData, meta interface{}) error
 		ExpireSec:   d.Get("expire_sec").(int),
 		RefreshSec:  d.Get("refresh_sec").(int),
 		TTLSec:      d.Get("ttl_sec").(int),
+		Tags:        expandTags(d, meta, nil),
 	}
 
 	if v, ok := d.GetOk("master_ips"); ok {
@@ -235,7 +244,7 @@ func resourceLinodeDomainCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLinodeDomainUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -254,6 +263,15 @@ func resourceLinodeDomainUpdate(d *schema.ResourceData, meta interface{}) error
 		TTLSec:      d.Get("ttl_sec").(int),
 	}
 
+	if hasTagsChange(d) {
+		domain, err := client.GetDomain(context.Background(), int(id))
+		if err != nil {
+			return fmt.Errorf("Error fetching data about the current Domain: %s", err)
+		}
+		tags := expandTags(d, meta, domain.Tags)
+		updateOpts.Tags = &tags
+	}
+
 	if v, ok := d.GetOk("master_ips"); ok {
 		var masterIPS []string
 		for _, ip := range v.([]interface{}) {
@@ -280,7 +298,7 @@ func resourceLinodeDomainUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLinodeDomainDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Domain id %s as int", d.Id())
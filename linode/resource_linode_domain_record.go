@@ -91,7 +91,7 @@ func resourceLinodeDomainRecord() *schema.Resource {
 }
 
 func resourceLinodeDomainRecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return false, fmt.Errorf("Error parsing Linode DomainRecord ID %s as int: %s", d.Id(), err)
@@ -142,7 +142,7 @@ func resourceLinodeDomainRecordImport(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLinodeDomainRecordRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode DomainRecord ID %s as int: %s", d.Id(), err)
@@ -193,10 +193,11 @@ func resourceDataIntOrNil(d *schema.ResourceData, name string) *int {
 }
 
 func resourceLinodeDomainRecordCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode DomainRecord")
 	}
+	client := providerMeta.Client
 	domainID := d.Get("domain_id").(int)
 
 	createOpts := linodego.DomainRecordCreateOptions{
@@ -223,7 +224,7 @@ func resourceLinodeDomainRecordCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLinodeDomainRecordUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	domainID := d.Get("domain_id").(int)
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -252,7 +253,7 @@ func resourceLinodeDomainRecordUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLinodeDomainRecordDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	domainID := d.Get("domain_id").(int)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -90,7 +90,7 @@ func TestAccLinodeDomainRecord_update(t *testing.T) {
 }
 
 func testAccCheckLinodeDomainRecordExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_domain_record" {
@@ -115,10 +115,11 @@ func testAccCheckLinodeDomainRecordExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeDomainRecordDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_domain_record" {
 			continue
@@ -36,6 +36,7 @@ func TestAccLinodeDomain_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "status"),
 					resource.TestCheckNoResourceAttr(resName, "master_ips"),
 					resource.TestCheckNoResourceAttr(resName, "axfr_ips"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
 				),
 			},
 
@@ -70,6 +71,7 @@ func TestAccLinodeDomain_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeDomainExists,
 					resource.TestCheckResourceAttr("linode_domain.foobar", "domain", fmt.Sprintf("renamed-%s", domainName)),
+					resource.TestCheckResourceAttr("linode_domain.foobar", "tags.#", "2"),
 				),
 			},
 		},
@@ -77,7 +79,7 @@ func TestAccLinodeDomain_update(t *testing.T) {
 }
 
 func testAccCheckLinodeDomainExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_domain" {
@@ -99,10 +101,11 @@ func testAccCheckLinodeDomainExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeDomainDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_domain" {
 			continue
@@ -139,6 +142,7 @@ resource "linode_domain" "foobar" {
 	status = "active"
 	soa_email = "example@%s"
 	description = "tf-testing"
+	tags = ["tf_test"]
 }`, domain, domain)
 }
 
@@ -150,5 +154,6 @@ resource "linode_domain" "foobar" {
 	status = "active"
 	soa_email = "example@%s"
 	description = "tf-testing"
+	tags = ["tf_test", "tf_test_r"]
 }`, domain, domain)
 }
@@ -88,7 +88,7 @@ func resourceLinodeImage() *schema.Resource {
 }
 
 func resourceLinodeImageExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	_, err := client.GetImage(context.Background(), d.Id())
 	if err != nil {
@@ -102,7 +102,7 @@ func resourceLinodeImageExists(d *schema.ResourceData, meta interface{}) (bool,
 }
 
 func resourceLinodeImageRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	image, err := client.GetImage(context.Background(), d.Id())
 
@@ -135,10 +135,11 @@ func resourceLinodeImageRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLinodeImageCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode Image")
 	}
+	client := providerMeta.Client
 	d.Partial(true)
 
 	linodeID := d.Get("linode_id").(int)
@@ -180,7 +181,7 @@ func resourceLinodeImageCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLinodeImageUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	image, err := client.GetImage(context.Background(), d.Id())
 	if err != nil {
@@ -210,7 +211,7 @@ func resourceLinodeImageUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceLinodeImageDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	err := client.DeleteImage(context.Background(), d.Id())
 	if err != nil {
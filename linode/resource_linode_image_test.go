@@ -90,7 +90,7 @@ func TestAccLinodeImage_update(t *testing.T) {
 }
 
 func testAccCheckLinodeImageExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_Image" {
@@ -106,10 +106,11 @@ func testAccCheckLinodeImageExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeImageDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_Image" {
 			continue
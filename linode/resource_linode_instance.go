@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,17 +21,60 @@ func resourceLinodeInstance() *schema.Resource {
 		Delete: resourceLinodeInstanceDelete,
 		Exists: resourceLinodeInstanceExists,
 
+		CustomizeDiff: resourceLinodeInstanceCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateWithTags(resourceLinodeInstanceRead),
 		},
+		SchemaVersion: 2,
+		MigrateState:  resourceLinodeInstanceMigrateState,
 		Schema: map[string]*schema.Schema{
 			"image": &schema.Schema{
 				Type:          schema.TypeString,
 				Description:   "An Image ID to deploy the Disk from. Official Linode Images start with linode/, while your Images start with private/. See /images for more information on the Images available for you to use.",
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"disk", "config", "backup_id"},
 			},
+			"clone_from": &schema.Schema{
+				Type:          schema.TypeList,
+				Description:   "An existing Linode Instance to clone the Disks and Configs of when creating this Linode Instance.",
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"image", "backup_id", "stackscript_id", "authorized_keys", "root_pass", "disk", "config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"linode_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "The ID of the Linode Instance to clone.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"disks": &schema.Schema{
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Set:         schema.HashInt,
+							Description: "The IDs of the Disks of the source Linode Instance to clone. If omitted, all Disks are cloned.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"configs": &schema.Schema{
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Set:         schema.HashInt,
+							Description: "The IDs of the Configs of the source Linode Instance to clone. If omitted, all Configs are cloned.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
 			"backup_id": &schema.Schema{
 				Type:          schema.TypeInt,
 				Description:   "A Backup ID from another Linode's available backups. Your User must have read_write access to that Linode, the Backup must have a status of successful, and the Linode must be deployed to the same region as the Backup. See /linode/instances/{linodeId}/backups for a Linode's available backups. This field and the image field are mutually exclusive.",
@@ -42,7 +86,6 @@ func resourceLinodeInstance() *schema.Resource {
 				Type:          schema.TypeInt,
 				Description:   "The StackScript to deploy to the newly created Linode. If provided, 'image' must also be provided, and must be an Image that is compatible with this StackScript.",
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"disk", "config"},
 			},
 			"stackscript_data": &schema.Schema{
@@ -51,10 +94,56 @@ func resourceLinodeInstance() *schema.Resource {
 
 				Description:   "An object containing responses to any User Defined Fields present in the StackScript being deployed to this Linode. Only accepted if 'stackscript_id' is given. The required values depend on the StackScript being deployed.",
 				Optional:      true,
-				ForceNew:      true,
 				Sensitive:     true,
 				ConflictsWith: []string{"disk", "config"},
 			},
+			"rebuild_on_change": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, changes to image, authorized_keys, root_pass, stackscript_id and stackscript_data will rebuild the Linode Instance in place, keeping its ID and IP addresses, rather than destroying and recreating it. All data on the existing disks is lost when rebuilding.",
+				Optional:    true,
+				Default:     false,
+			},
+			"reboot_policy": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Controls whether changes to disk, config or private_ip reboot the Linode Instance. auto reboots for any of these changes, never applies them and sets pending_reboot, and on_change_of only reboots for the changes listed in reboot_on_change_of.",
+				Optional:     true,
+				Default:      "auto",
+				ValidateFunc: validation.StringInSlice(instanceRebootPolicies, false),
+			},
+			"reboot_on_change_of": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(instanceRebootReasons, false),
+				},
+				Set:         schema.HashString,
+				Description: "The changes that reboot the Linode Instance when reboot_policy is on_change_of. Any of disk, config and private_ip.",
+				Optional:    true,
+			},
+			"pending_reboot": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, changes have been applied to the Linode Instance that only take effect once it is rebooted.",
+				Computed:    true,
+			},
+			"planned_reboot_reasons": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The changes that will reboot the running Linode Instance when the plan is applied. This is only set in a plan and is empty in the state.",
+				Computed:    true,
+			},
+			"shutdown_before_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, the Linode Instance will be shut down gracefully before it is deleted. If the shutdown does not complete within shutdown_timeout, the Linode Instance is deleted anyway.",
+				Optional:    true,
+				Default:     false,
+			},
+			"shutdown_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "The number of seconds to wait for the Linode Instance to shut down when shutdown_before_destroy is set.",
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"label": &schema.Schema{
 				Type:         schema.TypeString,
 				Description:  "The Linode's label is for display purposes only. If no label is provided for a Linode, a default will be assigned",
@@ -67,6 +156,8 @@ func resourceLinodeInstance() *schema.Resource {
 				Description: "The display group of the Linode instance.",
 				Optional:    true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"boot_config_label": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "The Label of the Instance Config that should be used to boot the Linode instance.",
@@ -75,25 +166,42 @@ func resourceLinodeInstance() *schema.Resource {
 			},
 			"region": &schema.Schema{
 				Type:         schema.TypeString,
-				Description:  "This is the location where the Linode was deployed. This cannot be changed without opening a support ticket.",
+				Description:  "This is the location where the Linode was deployed. Changing it recreates the Linode Instance, unless migrate_on_region_change is set.",
 				Required:     true,
-				ForceNew:     true,
 				InputDefault: "us-east",
 			},
+			"migrate_on_region_change": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, changing the region migrates the Linode Instance to the new region in place, keeping its disks, configs and ID, rather than destroying and recreating it. Its IP addresses change with the region.",
+				Optional:    true,
+				Default:     false,
+			},
 			"type": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "The type of instance to be deployed, determining the price and size.",
 				Optional:    true,
 				Default:     "g6-standard-1",
 			},
+			"resize_disk": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, changing the type of the Linode Instance grows or shrinks its largest disk by the change in disk space of the type. Can not be used with explicit disk blocks.",
+				Optional:    true,
+				Default:     false,
+			},
 			"status": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "The status of the instance, indicating the current readiness state.",
 				Computed:    true,
 			},
+			"booted": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, the Linode Instance will be kept powered on. If false, it will be kept powered off. If not set, the power state of the Linode Instance is not managed.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"ip_address": &schema.Schema{
 				Type:        schema.TypeString,
-				Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this Instance, an arbitrary address will be used for this field.",
+				Description: "This Linode's Public IPv4 Address. If there are multiple public IPv4 addresses on this Instance, the first address in ipv4_public is used for this field.",
 				Computed:    true,
 			},
 			"ipv6": &schema.Schema{
@@ -119,12 +227,53 @@ func resourceLinodeInstance() *schema.Resource {
 				Description: "This Linode's Private IPv4 Address.  The regional private IP address range is 192.168.128/17 address shared by all Linode Instances in a region.",
 				Computed:    true,
 			},
+			"ipv4_public": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "This Linode's public IPv4 addresses, in the order returned by the Linode API.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceIPResource(),
+			},
+			"ipv4_private": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "This Linode's private IPv4 addresses, in the order returned by the Linode API.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceIPResource(),
+			},
+			"ipv4_shared": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The IPv4 addresses of other Linode Instances that this Linode may bring up.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceIPResource(),
+			},
+			"ipv4_reserved": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The reserved IPv4 addresses assigned to this Linode.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceIPResource(),
+			},
+			"ipv6_slaac": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "This Linode's IPv6 SLAAC address, with its gateway and prefix.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceIPResource(),
+			},
+			"ipv6_link_local": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "This Linode's IPv6 link-local address.",
+				Computed:    true,
+				Elem:        resourceLinodeInstanceIPResource(),
+			},
+			"ipv6_ranges": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The IPv6 ranges routed to this Linode.",
+				Computed:    true,
+				Elem:        dataSourceLinodeIPv6RangeResource(),
+			},
 			"authorized_keys": &schema.Schema{
 				Type:          schema.TypeList,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				Description:   "A list of SSH public keys to deploy for the root user on the newly created Linode. Only accepted if 'image' is provided.",
 				Optional:      true,
-				ForceNew:      true,
 				StateFunc:     sshKeyState,
 				ConflictsWith: []string{"disk", "config"},
 			},
@@ -133,7 +282,6 @@ func resourceLinodeInstance() *schema.Resource {
 				Description:   "The password that will be initialially assigned to the 'root' user account.",
 				Sensitive:     true,
 				Optional:      true,
-				ForceNew:      true,
 				StateFunc:     rootPasswordState,
 				ConflictsWith: []string{"disk", "config"},
 			},
@@ -232,6 +380,7 @@ func resourceLinodeInstance() *schema.Resource {
 				Type:        schema.TypeList,
 				MaxItems:    1,
 				Description: "Information about this Linode's backups status.",
+				Optional:    true,
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -243,19 +392,23 @@ func resourceLinodeInstance() *schema.Resource {
 						"schedule": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
+							Optional: true,
 							Computed: true,
 							Elem: &schema.Resource{
-								// TODO(displague) these fields are updatable via PUT to instance
 								Schema: map[string]*schema.Schema{
 									"day": {
-										Type:        schema.TypeString,
-										Description: "The day ('Sunday'-'Saturday') of the week that your Linode's weekly Backup is taken. If not set manually, a day will be chosen for you. Backups are taken every day, but backups taken on this day are preferred when selecting backups to retain for a longer period.  If not set manually, then when backups are initially enabled, this may come back as 'Scheduling' until the day is automatically selected.",
-										Computed:    true,
+										Type:         schema.TypeString,
+										Description:  "The day ('Sunday'-'Saturday') of the week that your Linode's weekly Backup is taken. If not set manually, a day will be chosen for you. Backups are taken every day, but backups taken on this day are preferred when selecting backups to retain for a longer period.  If not set manually, then when backups are initially enabled, this may come back as 'Scheduling' until the day is automatically selected.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(backupScheduleDays, false),
 									},
 									"window": {
-										Type:        schema.TypeString,
-										Description: "The window ('W0'-'W22') in which your backups will be taken, in UTC. A backups window is a two-hour span of time in which the backup may occur. For example, 'W10' indicates that your backups should be taken between 10:00 and 12:00. If you do not choose a backup window, one will be selected for you automatically.  If not set manually, when backups are initially enabled this may come back as Scheduling until the window is automatically selected.",
-										Computed:    true,
+										Type:         schema.TypeString,
+										Description:  "The window ('W0'-'W22') in which your backups will be taken, in UTC. A backups window is a two-hour span of time in which the backup may occur. For example, 'W10' indicates that your backups should be taken between 10:00 and 12:00. If you do not choose a backup window, one will be selected for you automatically.  If not set manually, when backups are initially enabled this may come back as Scheduling until the window is automatically selected.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(backupScheduleWindows, false),
 									},
 								},
 							},
@@ -265,12 +418,14 @@ func resourceLinodeInstance() *schema.Resource {
 			},
 			"config": &schema.Schema{
 				Optional:      true,
-				Description:   "Configuration profiles define the VM settings and boot behavior of the Linode Instance.",
-				Type:          schema.TypeList,
+				Description:   "Configuration profiles define the VM settings and boot behavior of the Linode Instance. Configs are identified by their label.",
+				Type:          schema.TypeSet,
+				Set:           configHashcode,
 				ConflictsWith: []string{"image", "root_pass", "authorized_keys", "swap_size", "backup_id", "stackscript_id"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					_, hasImage := d.GetOk("image")
-					return hasImage
+					_, hasClone := d.GetOk("clone_from")
+					return hasImage || hasClone
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -321,210 +476,34 @@ func resourceLinodeInstance() *schema.Resource {
 								},
 							},
 						},
-						"devices": {
+						"device": {
 							Type:        schema.TypeList,
-							Description: "Device sda-sdh can be either a Disk or Volume identified by disk_label or volume_id. Only one type per slot allowed.",
-							MaxItems:    1,
+							Description: "The Disks and Volumes mapped to the device slots of this Config, in slot order. A device can be a Disk identified by disk_label or disk_id, or a Volume identified by volume_id.",
 							Optional:    true,
 							Computed:    true,
-							Default:     nil,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"sda": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Computed: true,
-										Optional: true,
-										Default:  nil,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:        schema.TypeString,
-													Optional:    true,
-													Description: "The `label` of the `disk` to map to this `device` slot.",
-												},
-												"disk_id": {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Computed:    true,
-													Description: "The Disk ID to map to this disk slot",
-												},
-												"volume_id": {
-													Type:        schema.TypeInt,
-													Optional:    true,
-													Description: "The Block Storage volume ID to map to this disk slot",
-												},
-											},
-										},
+									"slot": {
+										Type:         schema.TypeString,
+										Description:  "The device slot to map the Disk or Volume to (sda, sdb, ...).",
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(instanceConfigDeviceSlots, false),
 									},
-									"sdb": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Optional: true,
-										Computed: true,
-										Default:  nil,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"disk_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
-												},
-												"volume_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-									"sdc": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Optional: true,
-										Computed: true,
-										Default:  nil,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"disk_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
-												},
-												"volume_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-									"sdd": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Optional: true,
-										Computed: true,
-										Default:  nil,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"disk_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
-												},
-												"volume_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-									"sde": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Optional: true,
-										Computed: true,
-										Default:  nil,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"disk_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
-												},
-												"volume_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
-									},
-									"sdf": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Optional: true,
-										Computed: true,
-										Default:  nil,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"disk_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
-												},
-												"volume_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
+									"disk_label": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The `label` of the `disk` to map to this `device` slot.",
 									},
-									"sdg": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Optional: true,
-										Computed: true,
-										Default:  nil,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"disk_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
-												},
-												"volume_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
+									"disk_id": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Computed:    true,
+										Description: "The Disk ID to map to this device slot.",
 									},
-									"sdh": {
-										Type:     schema.TypeList,
-										MaxItems: 1,
-										Optional: true,
-										Computed: true,
-										Default:  nil,
-
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"disk_label": {
-													Type:     schema.TypeString,
-													Optional: true,
-												},
-												"disk_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-													Computed: true,
-												},
-												"volume_id": {
-													Type:     schema.TypeInt,
-													Optional: true,
-												},
-											},
-										},
+									"volume_id": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "The Block Storage volume ID to map to this device slot.",
 									},
 								},
 							},
@@ -570,11 +549,14 @@ func resourceLinodeInstance() *schema.Resource {
 			},
 			"disk": &schema.Schema{
 				Optional:      true,
+				Description:   "Disks of the Linode Instance, identified by their label.",
 				ConflictsWith: []string{"image", "root_pass", "authorized_keys", "swap_size", "backup_id", "stackscript_id"},
-				Type:          schema.TypeList,
+				Type:          schema.TypeSet,
+				Set:           labelHashcode,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					_, hasImage := d.GetOk("image")
-					return hasImage
+					_, hasClone := d.GetOk("clone_from")
+					return hasImage || hasClone
 				},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -676,6 +658,59 @@ func resourceLinodeInstance() *schema.Resource {
 	}
 }
 
+// resourceLinodeInstanceIPResource describes a single address of a Linode Instance
+func resourceLinodeInstanceIPResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The IP address.",
+				Computed:    true,
+			},
+			"gateway": {
+				Type:        schema.TypeString,
+				Description: "The default gateway of the address.",
+				Computed:    true,
+			},
+			"subnet_mask": {
+				Type:        schema.TypeString,
+				Description: "The subnet mask of the address.",
+				Computed:    true,
+			},
+			"prefix": {
+				Type:        schema.TypeInt,
+				Description: "The network prefix length of the address.",
+				Computed:    true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the address, ipv4, ipv6 or ipv6/pool.",
+				Computed:    true,
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Description: "If true, the address is public.",
+				Computed:    true,
+			},
+			"rdns": {
+				Type:        schema.TypeString,
+				Description: "The reverse DNS assigned to the address.",
+				Computed:    true,
+			},
+			"linode_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance the address is assigned to.",
+				Computed:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "The region the address is in.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
 func validateAll(validators ...schema.SchemaValidateFunc) schema.SchemaValidateFunc {
 	var allWs []string
 	var allErrors []error
@@ -690,7 +725,7 @@ func validateAll(validators ...schema.SchemaValidateFunc) schema.SchemaValidateF
 }
 
 func resourceLinodeInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 
 	if err != nil {
@@ -709,7 +744,7 @@ func resourceLinodeInstanceExists(d *schema.ResourceData, meta interface{}) (boo
 }
 
 func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode instance ID %s as int: %s", d.Id(), err)
@@ -739,6 +774,9 @@ func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("ipv4", ips)
 	d.Set("ipv6", instance.IPv6)
+	if err := setInstanceIPAddresses(d, instanceNetwork); err != nil {
+		return err
+	}
 	public, private := instanceNetwork.IPv4.Public, instanceNetwork.IPv4.Private
 
 	if len(public) > 0 {
@@ -762,10 +800,19 @@ func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("label", instance.Label)
 	d.Set("status", instance.Status)
+	d.Set("booted", isInstanceBooted(instance))
+	// pending changes are applied when a powered off Linode Instance is next booted
+	if !isInstanceBooted(instance) {
+		d.Set("pending_reboot", false)
+	}
 	d.Set("type", instance.Type)
 	d.Set("region", instance.Region)
+	// planned reboots are only shown in a plan
+	d.Set("planned_reboot_reasons", []string{})
 	d.Set("watchdog_enabled", instance.WatchdogEnabled)
 	d.Set("group", instance.Group)
+	d.Set("tags", flattenTags(d, meta, instance.Tags))
+	d.Set("tags_all", flattenTagsAll(d, meta, instance.Tags))
 
 	flatSpecs := flattenInstanceSpecs(*instance)
 	flatAlerts := flattenInstanceAlerts(*instance)
@@ -832,13 +879,23 @@ func sliceContains(a []string, x string) bool {
 }
 
 func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode Instance")
 	}
+	client := providerMeta.Client
+
+	// configs are a set keyed by label, so there is no first config to boot a new instance into when there are several
+	if d.Get("config").(*schema.Set).Len() > 1 && d.Get("boot_config_label").(string) == "" {
+		return fmt.Errorf("Error creating Linode Instance: boot_config_label must be set when there is more than one config")
+	}
+
 	d.Partial(true)
 
 	bootConfig := 0
+	booted, bootedOk := d.GetOkExists("booted")
+	keepOffline := bootedOk && !booted.(bool)
+
 	createOpts := linodego.InstanceCreateOptions{
 		Region:         d.Get("region").(string),
 		Type:           d.Get("type").(string),
@@ -846,13 +903,17 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		Group:          d.Get("group").(string),
 		BackupsEnabled: d.Get("backups_enabled").(bool),
 		PrivateIP:      d.Get("private_ip").(bool),
+		Tags:           expandTags(d, meta, nil),
 	}
 
 	_, disksOk := d.GetOk("disk")
 	_, configsOk := d.GetOk("config")
+	_, cloneOk := d.GetOk("clone_from")
 
-	// If we don't have disks and we don't have configs, use the single API call approach
-	if !disksOk && !configsOk {
+	if cloneOk {
+		createOpts.Booted = &boolFalse // clones are booted once the clone has finished
+	} else if !disksOk && !configsOk {
+		// If we don't have disks and we don't have configs, use the single API call approach
 		for _, key := range d.Get("authorized_keys").([]interface{}) {
 			createOpts.AuthorizedKeys = append(createOpts.AuthorizedKeys, key.(string))
 		}
@@ -866,6 +927,9 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 		createOpts.Image = d.Get("image").(string)
 		createOpts.Booted = &boolTrue
+		if keepOffline {
+			createOpts.Booted = &boolFalse
+		}
 		createOpts.BackupID = d.Get("backup_id").(int)
 		if swapSize := d.Get("swap_size").(int); swapSize > 0 {
 			createOpts.SwapSize = &swapSize
@@ -887,7 +951,13 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		createOpts.Booted = &boolFalse // necessary to prepare disks and configs
 	}
 
-	instance, err := client.CreateInstance(context.Background(), createOpts)
+	var instance *linodego.Instance
+	var err error
+	if cloneOk {
+		instance, err = cloneInstance(client, createOpts, d)
+	} else {
+		instance, err = client.CreateInstance(context.Background(), createOpts)
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating a Linode Instance: %s", err)
 	}
@@ -943,6 +1013,11 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		updateOpts.Alerts.NetworkOut = d.Get("alerts.0.transfer_quota").(int)
 	}
 
+	if _, scheduleOk := d.GetOk("backups.0.schedule.0"); scheduleOk {
+		doUpdate = true
+		updateOpts.Backups = expandInstanceBackupSchedule(d)
+	}
+
 	if doUpdate {
 		instance, err = client.UpdateInstance(context.Background(), instance.ID, updateOpts)
 		if err != nil {
@@ -963,7 +1038,7 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("Error waiting for Instance to finish creating")
 		}
 
-		dsetRaw := d.Get("disk").([]interface{})
+		dsetRaw := d.Get("disk").(*schema.Set).List()
 		diskIDLabelMap = make(map[string]int, len(dsetRaw))
 		diskIDOrdered = make([]int, len(dsetRaw))
 
@@ -981,7 +1056,7 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if configsOk {
-		cset := d.Get("config").([]interface{})
+		cset := d.Get("config").(*schema.Set).List()
 		detacher := makeVolumeDetacher(client, d)
 
 		configIDMap, err := createInstanceConfigsFromSet(client, instance.ID, cset, diskIDLabelMap, detacher)
@@ -990,18 +1065,30 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 		configIDLabelMap = make(map[string]int, len(configIDMap))
 		for k, v := range configIDMap {
-			if len(configIDLabelMap) == 1 {
+			if len(configIDMap) == 1 {
 				bootConfig = k
 			}
-
 			configIDLabelMap[v.Label] = k
 		}
+
+		// configs are keyed by label, so only a boot_config_label picks one of several configs deterministically
+		if bootConfigLabel := d.Get("boot_config_label").(string); len(bootConfigLabel) > 0 {
+			foundConfig, found := configIDLabelMap[bootConfigLabel]
+			if !found {
+				return fmt.Errorf("Error setting boot_config_label: Config label '%s' not found", bootConfigLabel)
+			}
+			bootConfig = foundConfig
+		}
 	}
 
 	d.Partial(false)
 
-	if createOpts.Booted == nil || !*createOpts.Booted {
-		if disksOk && configsOk {
+	if keepOffline {
+		if _, err = client.WaitForInstanceStatus(context.Background(), instance.ID, linodego.InstanceOffline, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+			return fmt.Errorf("Timed-out waiting for Linode instance %d to be created: %s", instance.ID, err)
+		}
+	} else if createOpts.Booted == nil || !*createOpts.Booted {
+		if (disksOk && configsOk) || cloneOk {
 			if err = client.BootInstance(context.Background(), instance.ID, bootConfig); err != nil {
 				return fmt.Errorf("Error booting Linode instance %d: %s", instance.ID, err)
 			}
@@ -1024,7 +1111,7 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -1054,6 +1141,14 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		simpleUpdate = true
 	}
 
+	if hasTagsChange(d) {
+		tags := expandTags(d, meta, instance.Tags)
+		updateOpts.Tags = &tags
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+		simpleUpdate = true
+	}
+
 	if d.HasChange("watchdog_enabled") {
 		watchdogEnabled := d.Get("watchdog_enabled").(bool)
 		updateOpts.WatchdogEnabled = &watchdogEnabled
@@ -1096,19 +1191,57 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.Partial(false)
 	}
 
+	// The schedule is updated once backups are enabled so both can be changed together
+	if d.HasChange("backups.0.schedule") {
+		d.Partial(true)
+		updateOpts := linodego.InstanceUpdateOptions{Backups: expandInstanceBackupSchedule(d)}
+		if _, err = client.UpdateInstance(context.Background(), instance.ID, updateOpts); err != nil {
+			return fmt.Errorf("Error updating the backup schedule of Instance %d: %s", instance.ID, err)
+		}
+		d.SetPartial("backups")
+		d.Partial(false)
+	}
+
+	if d.Get("rebuild_on_change").(bool) && hasInstanceRebuildChange(d) {
+		d.Partial(true)
+		if err = rebuildInstance(client, instance, d); err != nil {
+			return err
+		}
+		for _, key := range instanceRebuildKeys {
+			d.SetPartial(key)
+		}
+		d.Partial(false)
+	}
+
+	if d.HasChange("region") {
+		if err = migrateInstanceRegion(client, instance, d.Get("region").(string), d); err != nil {
+			return err
+		}
+		if instance, err = client.GetInstance(context.Background(), instance.ID); err != nil {
+			return fmt.Errorf("Error fetching data about the migrated Linode Instance %d: %s", instance.ID, err)
+		}
+		d.Set("region", instance.Region)
+	}
+
 	if d.HasChange("type") {
-		if err = changeInstanceType(&client, instance, d.Get("type").(string), d); err != nil {
+		if err = changeInstanceType(&client, instance, d.Get("type").(string), d.Get("resize_disk").(bool), d); err != nil {
 			return err
 		}
 		d.Set("type", d.Get("type").(string))
 	}
 
+	// rebootReasons are the changes that only take effect once the Linode Instance is rebooted
+	var rebootReasons []string
+
 	tfDisksOld, tfDisksNew := d.GetChange("disk")
 
-	rebootInstance, diskIDLabelMap, err := updateInstanceDisks(client, d, *instance, tfDisksOld, tfDisksNew)
+	diskRebootInstance, diskIDLabelMap, err := updateInstanceDisks(client, d, *instance, tfDisksOld, tfDisksNew)
 	if err != nil {
 		return err
 	}
+	if diskRebootInstance {
+		rebootReasons = append(rebootReasons, "disk")
+	}
 
 	if d.HasChange("private_ip") {
 		if !d.Get("private_ip").(bool) {
@@ -1126,16 +1259,29 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.Set("private_ip_address", resp.Address)
 		d.SetPartial("private_ip_address")
 		d.Partial(false)
-		rebootInstance = true
+		rebootReasons = append(rebootReasons, "private_ip")
 	}
 
 	tfConfigsOld, tfConfigsNew := d.GetChange("config")
-	cRebootInstance, updatedConfigMap, updatedConfigs, err := updateInstanceConfigs(client, d, *instance, tfConfigsOld, tfConfigsNew, diskIDLabelMap)
+	cRebootInstance, updatedConfigMap, _, err := updateInstanceConfigs(client, d, *instance, tfConfigsOld, tfConfigsNew, diskIDLabelMap)
 	if err != nil {
 		return err
 	}
-	rebootInstance = rebootInstance || cRebootInstance
+	if cRebootInstance {
+		rebootReasons = append(rebootReasons, "config")
+	}
+
+	// only an instance with explicit disks and configs is rebooted, others apply the changes on their next boot
+	if len(diskIDLabelMap) == 0 || len(updatedConfigMap) == 0 {
+		rebootReasons = nil
+	}
 
+	// a reboot deferred by the reboot_policy is caught up once the policy reboots for any change
+	if pendingReboot, _ := d.GetChange("pending_reboot"); pendingReboot.(bool) && d.Get("reboot_policy").(string) == "auto" {
+		rebootReasons = append(rebootReasons, "pending_reboot")
+	}
+
+	// with several configs and no boot_config_label, a bootConfig of 0 keeps the config the instance last booted into
 	bootConfig := 0
 
 	bootConfigLabel := d.Get("boot_config_label").(string)
@@ -1146,18 +1292,30 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		} else {
 			return fmt.Errorf("Error setting boot_config_label: Config label '%s' not found", bootConfigLabel)
 		}
-	} else if len(updatedConfigs) > 0 {
-		bootConfig = updatedConfigs[0].ID
+	} else if len(updatedConfigMap) == 1 {
+		for _, configID := range updatedConfigMap {
+			bootConfig = configID
+		}
 	}
 
-	if rebootInstance && len(diskIDLabelMap) > 0 && len(updatedConfigMap) > 0 && bootConfig > 0 {
+	rebootInstance := len(rebootReasons) > 0 && instanceRebootAllowed(d.Get("reboot_policy").(string), d.Get("reboot_on_change_of").(*schema.Set), rebootReasons)
+
+	// an instance that should be powered off is left off, its changes are applied on the next boot
+	if len(rebootReasons) > 0 && !rebootInstance && d.Get("booted").(bool) {
+		log.Printf("[INFO] Linode Instance %d is not rebooted by its reboot_policy; changes to %s are pending a reboot", instance.ID, strings.Join(rebootReasons, ", "))
+		d.Set("pending_reboot", true)
+	}
+
+	if rebootInstance && d.Get("booted").(bool) {
+		// allow for clock skew between the API and Terraform when looking for the reboot event
+		minStart := time.Now().Add(-time.Minute)
 		err = client.RebootInstance(context.Background(), instance.ID, bootConfig)
 
 		if err != nil {
 			return fmt.Errorf("Error rebooting Instance %d: %s", instance.ID, err)
 		}
 
-		_, err = client.WaitForEventFinished(context.Background(), id, linodego.EntityLinode, linodego.ActionLinodeReboot, *instance.Created, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
+		_, err = client.WaitForEventFinished(context.Background(), id, linodego.EntityLinode, linodego.ActionLinodeReboot, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds()))
 		if err != nil {
 			return fmt.Errorf("Error waiting for Instance %d to finish rebooting: %s", instance.ID, err)
 		}
@@ -1166,17 +1324,117 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("Timed-out waiting for Linode instance %d to boot: %s", instance.ID, err)
 		}
 
+		d.Set("pending_reboot", false)
+	}
+
+	if d.HasChange("booted") {
+		if instance, err = client.GetInstance(context.Background(), instance.ID); err != nil {
+			return fmt.Errorf("Error fetching data about the current linode: %s", err)
+		}
+
+		if booted := d.Get("booted").(bool); booted != isInstanceBooted(instance) {
+			if err = changeInstanceBootState(client, instance, booted, bootConfig, d); err != nil {
+				return err
+			}
+			d.Set("pending_reboot", false)
+		}
 	}
 
 	return resourceLinodeInstanceRead(d, meta)
 }
 
+func resourceLinodeInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffTags(d, meta); err != nil {
+		return err
+	}
+
+	for _, config := range d.Get("config").(*schema.Set).List() {
+		devices, _ := config.(map[string]interface{})["device"].([]interface{})
+		if err := validateInstanceConfigDeviceOrder(devices); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	// Rebuilding deploys a new image, so an instance without one has to be recreated
+	_, hasImage := d.GetOk("image")
+	rebuild := d.Get("rebuild_on_change").(bool) && hasImage
+
+	for _, key := range instanceRebuildKeys {
+		if d.HasChange(key) && !rebuild {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("region") && !d.Get("migrate_on_region_change").(bool) {
+		if err := d.ForceNew("region"); err != nil {
+			return err
+		}
+	} else if d.HasChange("region") {
+		// a migrated Linode Instance is assigned new addresses in its new region
+		for _, key := range instanceAddressKeys {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("type") {
+		resizeDisk := d.Get("resize_disk").(bool)
+		if resizeDisk && d.Get("disk").(*schema.Set).Len() > 0 {
+			return fmt.Errorf("Error resizing Linode Instance %s: resize_disk can not be used with explicit disk blocks, change the disk sizes instead", d.Id())
+		}
+
+		id, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error parsing Linode Instance ID %s as int: %s", d.Id(), err)
+		}
+		client := meta.(*ProviderMeta).Client
+		currentType, targetType := d.GetChange("type")
+		if _, err := instanceTypeDiskDelta(&client, id, currentType.(string), targetType.(string), resizeDisk); err != nil {
+			return err
+		}
+	}
+
+	// Terraform can not show warnings in a plan, so a planned reboot is shown by planned_reboot_reasons and a deferred one by pending_reboot
+	if d.Get("disk").(*schema.Set).Len() == 0 || d.Get("config").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	rebootReasons := plannedInstanceRebootReasons(d)
+	if pendingReboot := d.Get("pending_reboot").(bool); pendingReboot && d.Get("reboot_policy").(string) == "auto" {
+		rebootReasons = append(rebootReasons, "pending_reboot")
+	}
+	if len(rebootReasons) == 0 || !d.Get("booted").(bool) {
+		return nil
+	}
+
+	if instanceRebootAllowed(d.Get("reboot_policy").(string), d.Get("reboot_on_change_of").(*schema.Set), rebootReasons) {
+		log.Printf("[WARN] Linode Instance %s will be rebooted to apply changes to %s", d.Id(), strings.Join(rebootReasons, ", "))
+		if err := d.SetNew("planned_reboot_reasons", rebootReasons); err != nil {
+			return err
+		}
+		return d.SetNew("pending_reboot", false)
+	}
+
+	log.Printf("[WARN] Linode Instance %s will not be rebooted by its reboot_policy; changes to %s will be pending a reboot", d.Id(), strings.Join(rebootReasons, ", "))
+	return d.SetNew("pending_reboot", true)
+}
+
 func resourceLinodeInstanceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Instance ID %s as int", d.Id())
 	}
+	if d.Get("shutdown_before_destroy").(bool) {
+		shutdownInstanceBeforeDestroy(client, int(id), d.Get("shutdown_timeout").(int))
+	}
+
 	minDelete := time.Now().AddDate(0, 0, -1)
 	err = client.DeleteInstance(context.Background(), int(id))
 	if err != nil {
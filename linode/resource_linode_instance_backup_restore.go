@@ -0,0 +1,108 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeInstanceBackupRestore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceBackupRestoreCreate,
+		Read:   resourceLinodeInstanceBackupRestoreRead,
+		Delete: resourceLinodeInstanceBackupRestoreDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance the Backup belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"backup_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Backup or Snapshot to restore.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target_linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to restore the Backup to. Defaults to the Linode Instance the Backup belongs to.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"overwrite": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, deletes all Disks and Configs on the target Linode Instance before restoring.",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceBackupRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	targetID := d.Get("target_linode_id").(int)
+
+	// A restore can not be read back, it is kept in state for as long as its target exists
+	if _, err := client.GetInstance(context.Background(), targetID); err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance Backup Restore %q from state because Linode Instance %d no longer exists", d.Id(), targetID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the Linode Instance %d the Backup was restored to: %s", targetID, err)
+	}
+
+	return nil
+}
+
+func resourceLinodeInstanceBackupRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Backup Restore")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+	backupID := d.Get("backup_id").(int)
+	targetID := linodeID
+	if v, ok := d.GetOk("target_linode_id"); ok {
+		targetID = v.(int)
+	}
+
+	restoreOpts := linodego.RestoreInstanceOptions{
+		LinodeID:  targetID,
+		Overwrite: d.Get("overwrite").(bool),
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the restore event
+	minStart := time.Now().Add(-time.Minute)
+	if err := client.RestoreInstanceBackup(context.Background(), linodeID, backupID, restoreOpts); err != nil {
+		return fmt.Errorf("Error restoring Linode Instance %d Backup %d to Linode Instance %d: %s", linodeID, backupID, targetID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d,%d,%d", linodeID, backupID, targetID))
+	d.Set("target_linode_id", targetID)
+
+	if _, err := client.WaitForEventFinished(context.Background(), targetID, linodego.EntityLinode, linodego.ActionBackupsRestore, minStart, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d Backup %d to be restored to Linode Instance %d: %s", linodeID, backupID, targetID, err)
+	}
+
+	return resourceLinodeInstanceBackupRestoreRead(d, meta)
+}
+
+func resourceLinodeInstanceBackupRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	// A restore can not be undone, destroying it only removes it from state
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,97 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLinodeInstanceBackupRestore_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_backup_restore.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceBackupRestoreConfigBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceBackupRestoreTargetDisks,
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resName, "backup_id", "linode_instance_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resName, "target_linode_id", "linode_instance.target", "id"),
+					resource.TestCheckResourceAttr(resName, "overwrite", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceBackupRestoreTargetDisks(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_backup_restore" {
+			continue
+		}
+
+		targetID, err := strconv.Atoi(rs.Primary.Attributes["target_linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing target_linode_id %v to int", rs.Primary.Attributes["target_linode_id"])
+		}
+
+		disks, err := client.ListInstanceDisks(context.Background(), targetID, nil)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the disks of Linode Instance %d: %s", targetID, err)
+		}
+		if len(disks) == 0 {
+			return fmt.Errorf("Expected the Backup to be restored to Linode Instance %d, found no disks", targetID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeInstanceBackupRestoreConfigBasic(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	backups_enabled = true
+}
+
+resource "linode_instance_snapshot" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "%s"
+}
+
+resource "linode_instance" "target" {
+	label = "%s_target"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	booted = false
+}
+
+resource "linode_instance_backup_restore" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	backup_id = "${linode_instance_snapshot.foobar.id}"
+	target_linode_id = "${linode_instance.target.id}"
+	overwrite = true
+}`, instance, instance, instance)
+}
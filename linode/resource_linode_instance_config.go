@@ -0,0 +1,422 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeInstanceConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceConfigCreate,
+		Read:   resourceLinodeInstanceConfigRead,
+		Update: resourceLinodeInstanceConfigUpdate,
+		Delete: resourceLinodeInstanceConfigDelete,
+		Exists: resourceLinodeInstanceConfigExists,
+
+		CustomizeDiff: resourceLinodeInstanceConfigCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceLinodeInstanceConfigImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance the Config belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The Config's label for display purposes.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 48),
+			},
+			"comments": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Optional field for arbitrary User comments on this Config.",
+				Optional:    true,
+			},
+			"kernel": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A Kernel ID to boot a Linode with. Defaults to linode/latest-64bit. (examples: linode/latest-64bit, linode/grub2, linode/direct-disk)",
+				Optional:    true,
+				Computed:    true,
+			},
+			"run_level": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Defines the state of your Linode after booting. Defaults to default.",
+				Optional:     true,
+				Default:      "default",
+				ValidateFunc: validation.StringInSlice([]string{"default", "single", "binbash"}, false),
+			},
+			"virt_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Controls the virtualization mode. Defaults to paravirt.",
+				Optional:     true,
+				Default:      "paravirt",
+				ValidateFunc: validation.StringInSlice([]string{"paravirt", "fullvirt"}, false),
+			},
+			"root_device": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The root device to boot. The corresponding disk must be attached.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"memory_limit": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Defaults to the total RAM of the Linode",
+				Optional:    true,
+			},
+			"helpers": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Helpers enabled when booting to this Linode Config.",
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"updatedb_disabled": {
+							Type:        schema.TypeBool,
+							Description: "Disables updatedb cron job to avoid disk thrashing.",
+							Optional:    true,
+							Default:     true,
+						},
+						"distro": {
+							Type:        schema.TypeBool,
+							Description: "Controls the behavior of the Linode Config's Distribution Helper setting.",
+							Optional:    true,
+							Default:     true,
+						},
+						"modules_dep": {
+							Type:        schema.TypeBool,
+							Description: "Creates a modules dependency file for the Kernel you run.",
+							Optional:    true,
+							Default:     true,
+						},
+						"network": {
+							Type:        schema.TypeBool,
+							Description: "Controls the behavior of the Linode Config's Network Helper setting, used to automatically configure additional IP addresses assigned to this instance.",
+							Optional:    true,
+							Default:     true,
+						},
+						"devtmpfs_automount": {
+							Type:        schema.TypeBool,
+							Description: "Populates the /dev directory early during boot without udev. Defaults to false.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"device": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The Disks and Volumes mapped to the device slots of this Config, in slot order. A device can be a Disk identified by disk_id, or a Volume identified by volume_id.",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slot": {
+							Type:         schema.TypeString,
+							Description:  "The device slot to map the Disk or Volume to (sda, sdb, ...).",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(instanceConfigDeviceSlots, false),
+						},
+						"disk_id": {
+							Type:        schema.TypeInt,
+							Description: "The Disk ID to map to this device slot.",
+							Optional:    true,
+						},
+						"volume_id": {
+							Type:        schema.TypeInt,
+							Description: "The Block Storage volume ID to map to this device slot.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"booted": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, the Linode Instance is booted into this Config, and rebooted into it when the Config changes. This is not refreshed from the Linode Instance.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceConfigExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing Linode Instance Config ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	_, err = client.GetInstanceConfig(context.Background(), linodeID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error getting Linode Instance %d Config %s: %s", linodeID, d.Id(), err)
+	}
+	return true, nil
+}
+
+func resourceLinodeInstanceConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance Config ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	config, err := client.GetInstanceConfig(context.Background(), linodeID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance %d Config %q from state because it no longer exists", linodeID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode Instance %d Config: %s", linodeID, err)
+	}
+
+	d.Set("label", config.Label)
+	d.Set("comments", config.Comments)
+	d.Set("kernel", config.Kernel)
+	d.Set("run_level", config.RunLevel)
+	d.Set("virt_mode", config.VirtMode)
+	d.Set("root_device", config.RootDevice)
+	d.Set("memory_limit", config.MemoryLimit)
+
+	if err := d.Set("helpers", flattenInstanceConfigHelpers(config.Helpers)); err != nil {
+		return fmt.Errorf("Error setting Linode Instance %d Config %d helpers: %s", linodeID, config.ID, err)
+	}
+
+	if err := d.Set("device", flattenInstanceConfigDeviceMap(config.Devices, nil)); err != nil {
+		return fmt.Errorf("Error setting Linode Instance %d Config %d devices: %s", linodeID, config.ID, err)
+	}
+
+	// booted is only applied, as the API does not report which Config a Linode Instance booted into
+
+	return nil
+}
+
+func resourceLinodeInstanceConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		// Validate that this is an ID by making sure it can be converted into an int
+		_, err := strconv.Atoi(s[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid config ID: %v", err)
+		}
+
+		linodeID, err := strconv.Atoi(s[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid linode ID: %v", err)
+		}
+
+		d.SetId(s[1])
+		d.Set("linode_id", linodeID)
+	}
+	d.Set("booted", false)
+
+	err := resourceLinodeInstanceConfigRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import %v as instance_config: %v", d.Id(), err)
+	}
+
+	results := make([]*schema.ResourceData, 0)
+	results = append(results, d)
+
+	return results, nil
+}
+
+func resourceLinodeInstanceConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Config")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+	createOpts := linodego.InstanceConfigCreateOptions{
+		Label:       d.Get("label").(string),
+		Comments:    d.Get("comments").(string),
+		Kernel:      d.Get("kernel").(string),
+		RunLevel:    d.Get("run_level").(string),
+		VirtMode:    d.Get("virt_mode").(string),
+		MemoryLimit: d.Get("memory_limit").(int),
+		Helpers:     expandInstanceConfigHelpers(d.Get("helpers").([]interface{})),
+	}
+
+	if rootDevice, ok := d.GetOk("root_device"); ok {
+		rootDeviceStr := rootDevice.(string)
+		createOpts.RootDevice = &rootDeviceStr
+	}
+
+	devices, err := expandInstanceConfigDeviceMap(d.Get("device").([]interface{}), nil)
+	if err != nil {
+		return err
+	}
+	if devices != nil {
+		createOpts.Devices = *devices
+	}
+
+	if err = detachConfigVolumes(createOpts.Devices, makeVolumeDetacher(client, d)); err != nil {
+		return err
+	}
+
+	config, err := client.CreateInstanceConfig(context.Background(), linodeID, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating a Linode Instance %d Config: %s", linodeID, err)
+	}
+	d.SetId(fmt.Sprintf("%d", config.ID))
+
+	if d.Get("booted").(bool) {
+		if err = bootInstanceConfig(client, linodeID, config.ID, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceLinodeInstanceConfigRead(d, meta)
+}
+
+func resourceLinodeInstanceConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance Config ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	config, err := client.GetInstanceConfig(context.Background(), linodeID, int(id))
+	if err != nil {
+		return fmt.Errorf("Error fetching data about the current Linode Instance %d Config: %s", linodeID, err)
+	}
+
+	configChanged := d.HasChange("label") || d.HasChange("comments") || d.HasChange("kernel") ||
+		d.HasChange("run_level") || d.HasChange("virt_mode") || d.HasChange("root_device") ||
+		d.HasChange("memory_limit") || d.HasChange("helpers") || d.HasChange("device")
+
+	if configChanged {
+		updateOpts := config.GetUpdateOptions()
+		updateOpts.Label = d.Get("label").(string)
+		updateOpts.Comments = d.Get("comments").(string)
+		updateOpts.Kernel = d.Get("kernel").(string)
+		updateOpts.RunLevel = d.Get("run_level").(string)
+		updateOpts.VirtMode = d.Get("virt_mode").(string)
+		updateOpts.RootDevice = d.Get("root_device").(string)
+		updateOpts.MemoryLimit = d.Get("memory_limit").(int)
+
+		if helpers := expandInstanceConfigHelpers(d.Get("helpers").([]interface{})); helpers != nil {
+			updateOpts.Helpers = helpers
+		}
+
+		if updateOpts.Devices, err = expandInstanceConfigDeviceMap(d.Get("device").([]interface{}), nil); err != nil {
+			return err
+		}
+		if updateOpts.Devices != nil {
+			if err = detachConfigVolumes(*updateOpts.Devices, makeVolumeDetacher(client, d)); err != nil {
+				return err
+			}
+		}
+
+		if _, err = client.UpdateInstanceConfig(context.Background(), linodeID, config.ID, updateOpts); err != nil {
+			return fmt.Errorf("Error updating Linode Instance %d Config %d: %s", linodeID, config.ID, err)
+		}
+	}
+
+	// Config changes only take effect once the Linode Instance is rebooted into the Config
+	if d.Get("booted").(bool) && (configChanged || d.HasChange("booted")) {
+		if err = bootInstanceConfig(client, linodeID, config.ID, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceLinodeInstanceConfigRead(d, meta)
+}
+
+func resourceLinodeInstanceConfigCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	return validateInstanceConfigDeviceOrder(d.Get("device").([]interface{}))
+}
+
+func resourceLinodeInstanceConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance Config ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	if err = client.DeleteInstanceConfig(context.Background(), linodeID, int(id)); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Error deleting Linode Instance %d Config %d: %s", linodeID, id, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// bootInstanceConfig boots a powered off Linode Instance into the Config, or reboots a running one into it
+func bootInstanceConfig(client linodego.Client, linodeID int, configID int, d *schema.ResourceData) error {
+	instance, err := client.GetInstance(context.Background(), linodeID)
+	if err != nil {
+		return fmt.Errorf("Error fetching data about the current linode: %s", err)
+	}
+
+	if !isInstanceBooted(instance) {
+		return changeInstanceBootState(client, instance, true, configID, d)
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the reboot event
+	minStart := time.Now().Add(-time.Minute)
+	if err = client.RebootInstance(context.Background(), linodeID, configID); err != nil {
+		return fmt.Errorf("Error rebooting Instance %d into Config %d: %s", linodeID, configID, err)
+	}
+
+	if _, err = client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionLinodeReboot, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Instance %d to finish rebooting: %s", linodeID, err)
+	}
+
+	if _, err = client.WaitForInstanceStatus(context.Background(), linodeID, linodego.InstanceRunning, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode instance %d to boot: %s", linodeID, err)
+	}
+	return nil
+}
+
+// expandInstanceConfigHelpers converts a terraform helpers list to InstanceConfigHelpers, or nil when none are configured
+func expandInstanceConfigHelpers(helpers []interface{}) *linodego.InstanceConfigHelpers {
+	if len(helpers) == 0 || helpers[0] == nil {
+		return nil
+	}
+	helpersMap := helpers[0].(map[string]interface{})
+	return &linodego.InstanceConfigHelpers{
+		UpdateDBDisabled:  helpersMap["updatedb_disabled"].(bool),
+		Distro:            helpersMap["distro"].(bool),
+		ModulesDep:        helpersMap["modules_dep"].(bool),
+		Network:           helpersMap["network"].(bool),
+		DevTmpFsAutomount: helpersMap["devtmpfs_automount"].(bool),
+	}
+}
+
+func flattenInstanceConfigHelpers(helpers *linodego.InstanceConfigHelpers) []map[string]bool {
+	if helpers == nil {
+		return nil
+	}
+	return []map[string]bool{{
+		"updatedb_disabled":  helpers.UpdateDBDisabled,
+		"distro":             helpers.Distro,
+		"modules_dep":        helpers.ModulesDep,
+		"network":            helpers.Network,
+		"devtmpfs_automount": helpers.DevTmpFsAutomount,
+	}}
+}
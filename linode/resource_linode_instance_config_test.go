@@ -0,0 +1,193 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeInstanceConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_config.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigResourceBasic(instanceName, "maintenance", "single", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceConfigResourceExists,
+					resource.TestCheckResourceAttr(resName, "label", "maintenance"),
+					resource.TestCheckResourceAttr(resName, "run_level", "single"),
+					resource.TestCheckResourceAttr(resName, "helpers.0.network", "false"),
+					resource.TestCheckResourceAttr(resName, "device.0.slot", "sda"),
+					resource.TestCheckResourceAttrPair(resName, "device.0.disk_id", "linode_instance_disk.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "booted", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStateIDInstanceConfig,
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstanceConfig_booted(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_config.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceConfigDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigResourceBasic(instanceName, "maintenance", "default", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceConfigResourceExists,
+					resource.TestCheckResourceAttr(resName, "booted", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigResourceBasic(instanceName, "maintenance_r", "default", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceConfigResourceExists,
+					resource.TestCheckResourceAttr(resName, "label", "maintenance_r"),
+					resource.TestCheckResourceAttr(resName, "booted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceConfigResourceExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_config" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		_, err = client.GetInstanceConfig(context.Background(), linodeID, id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Instance %d Config %s: %s", linodeID, rs.Primary.Attributes["label"], err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeInstanceConfigDestroy(s *terraform.State) error {
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Error getting Linode client")
+	}
+	client := providerMeta.Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_config" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		_, err = client.GetInstanceConfig(context.Background(), linodeID, id)
+
+		if err == nil {
+			return fmt.Errorf("Linode Instance %d Config %d still exists", linodeID, id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Error requesting Linode Instance %d Config %d", linodeID, id)
+		}
+	}
+
+	return nil
+}
+
+func testAccStateIDInstanceConfig(s *terraform.State) (string, error) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_config" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return "", fmt.Errorf("Error parsing ID %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return "", fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+		return fmt.Sprintf("%d,%d", linodeID, id), nil
+	}
+
+	return "", fmt.Errorf("Error finding linode_instance_config")
+}
+
+func testAccCheckLinodeInstanceConfigResourceBasic(instance string, label string, runLevel string, booted bool) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance_disk" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "boot"
+	size = 3000
+	image = "linode/ubuntu18.04"
+	root_pass = "terraform-test"
+}
+
+resource "linode_instance_config" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "%s"
+	kernel = "linode/latest-64bit"
+	run_level = "%s"
+	root_device = "/dev/sda"
+	booted = %t
+
+	helpers {
+		network = false
+	}
+
+	device {
+		slot = "sda"
+		disk_id = "${linode_instance_disk.foobar.id}"
+	}
+}`, instance, label, runLevel, booted)
+}
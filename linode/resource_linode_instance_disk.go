@@ -0,0 +1,313 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeInstanceDisk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceDiskCreate,
+		Read:   resourceLinodeInstanceDiskRead,
+		Update: resourceLinodeInstanceDiskUpdate,
+		Delete: resourceLinodeInstanceDiskDelete,
+		Exists: resourceLinodeInstanceDiskExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceLinodeInstanceDiskImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance the Disk belongs to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The Disk's label for display purposes only.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 48),
+			},
+			"size": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The size of the Disk in MB. The Disk is resized in place when this changes.",
+				Required:    true,
+			},
+			"filesystem": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The Disk filesystem can be one of: raw, swap, ext3, ext4, initrd (max 32mb)",
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"raw", "swap", "ext3", "ext4", "initrd"}, false),
+			},
+			"image": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "An Image ID to deploy the Disk from. Official Linode Images start with linode/, while your Images start with private/.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"authorized_keys": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A list of SSH public keys to deploy for the root user on the newly created Disk. Only accepted if 'image' is provided.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"root_pass": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The password that will be initialially assigned to the 'root' user account. Only accepted if 'image' is provided.",
+				Sensitive:    true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(6, 128),
+				StateFunc:    rootPasswordState,
+			},
+			"stackscript_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The StackScript to deploy to the newly created Disk. If provided, 'image' must also be provided, and must be an Image that is compatible with this StackScript.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"stackscript_data": &schema.Schema{
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "An object containing responses to any User Defined Fields present in the StackScript being deployed to this Disk. Only accepted if 'stackscript_id' is given.",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The status of the Disk.",
+				Computed:    true,
+			},
+			"created": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the Disk was created.",
+				Computed:    true,
+			},
+			"updated": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the Disk was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceDiskExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing Linode Instance Disk ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	_, err = client.GetInstanceDisk(context.Background(), linodeID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error getting Linode Instance %d Disk %s: %s", linodeID, d.Id(), err)
+	}
+	return true, nil
+}
+
+func resourceLinodeInstanceDiskRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance Disk ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	disk, err := client.GetInstanceDisk(context.Background(), linodeID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance %d Disk %q from state because it no longer exists", linodeID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode Instance %d Disk: %s", linodeID, err)
+	}
+
+	d.Set("label", disk.Label)
+	d.Set("size", disk.Size)
+	d.Set("filesystem", string(disk.Filesystem))
+	d.Set("status", string(disk.Status))
+	d.Set("created", disk.Created.Format(time.RFC3339))
+	d.Set("updated", disk.Updated.Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceLinodeInstanceDiskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		// Validate that this is an ID by making sure it can be converted into an int
+		_, err := strconv.Atoi(s[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid disk ID: %v", err)
+		}
+
+		linodeID, err := strconv.Atoi(s[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid linode ID: %v", err)
+		}
+
+		d.SetId(s[1])
+		d.Set("linode_id", linodeID)
+	}
+
+	err := resourceLinodeInstanceDiskRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import %v as instance_disk: %v", d.Id(), err)
+	}
+
+	results := make([]*schema.ResourceData, 0)
+	results = append(results, d)
+
+	return results, nil
+}
+
+func resourceLinodeInstanceDiskCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Disk")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+	createOpts := linodego.InstanceDiskCreateOptions{
+		Label:      d.Get("label").(string),
+		Size:       d.Get("size").(int),
+		Filesystem: d.Get("filesystem").(string),
+	}
+
+	if image, ok := d.GetOk("image"); ok {
+		createOpts.Image = image.(string)
+
+		createOpts.RootPass = d.Get("root_pass").(string)
+		if createOpts.RootPass == "" {
+			var err error
+			if createOpts.RootPass, err = createRandomRootPassword(); err != nil {
+				return err
+			}
+		}
+
+		for _, key := range d.Get("authorized_keys").([]interface{}) {
+			createOpts.AuthorizedKeys = append(createOpts.AuthorizedKeys, key.(string))
+		}
+
+		createOpts.StackscriptID = d.Get("stackscript_id").(int)
+
+		if stackscriptData, ok := d.Get("stackscript_data").(map[string]interface{}); ok && len(stackscriptData) > 0 {
+			createOpts.StackscriptData = make(map[string]string, len(stackscriptData))
+			for name, value := range stackscriptData {
+				createOpts.StackscriptData[name] = value.(string)
+			}
+		}
+	}
+
+	disk, err := client.CreateInstanceDisk(context.Background(), linodeID, createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating a Linode Instance %d Disk: %s", linodeID, err)
+	}
+	d.SetId(fmt.Sprintf("%d", disk.ID))
+
+	if _, err = client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionDiskCreate, disk.Created, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d Disk %d to be created: %s", linodeID, disk.ID, err)
+	}
+
+	if _, err = client.WaitForInstanceDiskStatus(context.Background(), linodeID, disk.ID, linodego.DiskReady, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode Instance %d Disk %d to be ready: %s", linodeID, disk.ID, err)
+	}
+
+	return resourceLinodeInstanceDiskRead(d, meta)
+}
+
+func resourceLinodeInstanceDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance Disk ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	disk, err := client.GetInstanceDisk(context.Background(), linodeID, int(id))
+	if err != nil {
+		return fmt.Errorf("Error fetching data about the current Linode Instance %d Disk: %s", linodeID, err)
+	}
+
+	d.Partial(true)
+
+	if d.HasChange("label") {
+		if disk, err = client.RenameInstanceDisk(context.Background(), linodeID, disk.ID, d.Get("label").(string)); err != nil {
+			return fmt.Errorf("Error renaming Linode Instance %d Disk %d: %s", linodeID, int(id), err)
+		}
+		d.SetPartial("label")
+	}
+
+	if d.HasChange("size") {
+		size := d.Get("size").(int)
+		// allow for clock skew between the API and Terraform when looking for the disk resize event
+		minStart := time.Now().Add(-time.Minute)
+		if err = client.ResizeInstanceDisk(context.Background(), linodeID, disk.ID, size); err != nil {
+			return fmt.Errorf("Error resizing Linode Instance %d Disk %d: %s", linodeID, disk.ID, err)
+		}
+
+		if _, err = client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionDiskResize, minStart, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("Error waiting for resize of Linode Instance %d Disk %d: %s", linodeID, disk.ID, err)
+		}
+
+		if _, err = client.WaitForInstanceDiskStatus(context.Background(), linodeID, disk.ID, linodego.DiskReady, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return fmt.Errorf("Timed-out waiting for Linode Instance %d Disk %d to be ready: %s", linodeID, disk.ID, err)
+		}
+		d.SetPartial("size")
+	}
+
+	d.Partial(false)
+
+	return resourceLinodeInstanceDiskRead(d, meta)
+}
+
+func resourceLinodeInstanceDiskDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance Disk ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	disk, err := client.GetInstanceDisk(context.Background(), linodeID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error fetching data about the current Linode Instance %d Disk: %s", linodeID, err)
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the disk delete event
+	minStart := time.Now().Add(-time.Minute)
+	if err = client.DeleteInstanceDisk(context.Background(), linodeID, disk.ID); err != nil {
+		return fmt.Errorf("Error deleting Linode Instance %d Disk %d: %s", linodeID, disk.ID, err)
+	}
+
+	if _, err = client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionDiskDelete, minStart, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d Disk %d to finish deleting: %s", linodeID, disk.ID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,172 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeInstanceDisk_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_disk.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceDiskConfigBasic(instanceName, "data", 1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceDiskResourceExists,
+					resource.TestCheckResourceAttr(resName, "label", "data"),
+					resource.TestCheckResourceAttr(resName, "size", "1024"),
+					resource.TestCheckResourceAttr(resName, "filesystem", "ext4"),
+					resource.TestCheckResourceAttr(resName, "status", "ready"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStateIDInstanceDisk,
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstanceDisk_update(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_disk.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDiskDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceDiskConfigBasic(instanceName, "data", 1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceDiskResourceExists,
+					resource.TestCheckResourceAttr(resName, "size", "1024"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceDiskConfigBasic(instanceName, "data_r", 2048),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceDiskResourceExists,
+					resource.TestCheckResourceAttr(resName, "label", "data_r"),
+					resource.TestCheckResourceAttr(resName, "size", "2048"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceDiskResourceExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_disk" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		_, err = client.GetInstanceDisk(context.Background(), linodeID, id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Instance %d Disk %s: %s", linodeID, rs.Primary.Attributes["label"], err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeInstanceDiskDestroy(s *terraform.State) error {
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Error getting Linode client")
+	}
+	client := providerMeta.Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_disk" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		_, err = client.GetInstanceDisk(context.Background(), linodeID, id)
+
+		if err == nil {
+			return fmt.Errorf("Linode Instance %d Disk %d still exists", linodeID, id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Error requesting Linode Instance %d Disk %d", linodeID, id)
+		}
+	}
+
+	return nil
+}
+
+func testAccStateIDInstanceDisk(s *terraform.State) (string, error) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_disk" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return "", fmt.Errorf("Error parsing ID %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return "", fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+		return fmt.Sprintf("%d,%d", linodeID, id), nil
+	}
+
+	return "", fmt.Errorf("Error finding linode_instance_disk")
+}
+
+func testAccCheckLinodeInstanceDiskConfigBasic(instance string, label string, size int) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance_disk" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "%s"
+	size = %d
+	filesystem = "ext4"
+}`, instance, label, size)
+}
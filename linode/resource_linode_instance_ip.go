@@ -0,0 +1,204 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeInstanceIP() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceIPCreate,
+		Read:   resourceLinodeInstanceIPRead,
+		Update: resourceLinodeInstanceIPUpdate,
+		Delete: resourceLinodeInstanceIPDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLinodeInstanceIPImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to allocate the IPv4 address to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"public": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, the allocated IPv4 address is public, otherwise it is private.",
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+			"apply_immediately": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, a running Linode Instance is rebooted once the IPv4 address is allocated, so that Network Helper configures it.",
+				Optional:    true,
+				Default:     false,
+			},
+			"address": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The allocated IPv4 address.",
+				Computed:    true,
+			},
+			"gateway": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The default gateway of the IPv4 address.",
+				Computed:    true,
+			},
+			"subnet_mask": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The subnet mask of the IPv4 address.",
+				Computed:    true,
+			},
+			"prefix": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The network prefix length of the IPv4 address.",
+				Computed:    true,
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The type of the IP address.",
+				Computed:    true,
+			},
+			"rdns": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The reverse DNS assigned to the IPv4 address.",
+				Computed:    true,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The region the IPv4 address is in.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceIPRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	linodeID := d.Get("linode_id").(int)
+
+	ip, err := client.GetInstanceIPAddress(context.Background(), linodeID, d.Id())
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance %d IP %q from state because it no longer exists", linodeID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode Instance %d IP: %s", linodeID, err)
+	}
+
+	d.Set("address", ip.Address)
+	d.Set("public", ip.Public)
+	d.Set("gateway", ip.Gateway)
+	d.Set("subnet_mask", ip.SubnetMask)
+	d.Set("prefix", ip.Prefix)
+	d.Set("type", ip.Type)
+	d.Set("rdns", ip.RDNS)
+	d.Set("region", ip.Region)
+
+	return nil
+}
+
+func resourceLinodeInstanceIPImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+
+		linodeID, err := strconv.Atoi(s[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid linode ID: %v", err)
+		}
+
+		d.SetId(s[1])
+		d.Set("linode_id", linodeID)
+	}
+
+	err := resourceLinodeInstanceIPRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import %v as instance_ip: %v", d.Id(), err)
+	}
+
+	results := make([]*schema.ResourceData, 0)
+	results = append(results, d)
+
+	return results, nil
+}
+
+func resourceLinodeInstanceIPCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance IP")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+
+	ip, err := client.AddInstanceIPAddress(context.Background(), linodeID, d.Get("public").(bool))
+	if err != nil {
+		return fmt.Errorf("Error allocating an IP to Linode Instance %d: %s", linodeID, err)
+	}
+	d.SetId(ip.Address)
+
+	if d.Get("apply_immediately").(bool) {
+		if err = rebootInstanceForIP(client, linodeID, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceLinodeInstanceIPRead(d, meta)
+}
+
+func resourceLinodeInstanceIPUpdate(d *schema.ResourceData, meta interface{}) error {
+	// apply_immediately is only used when the IP is allocated
+	return resourceLinodeInstanceIPRead(d, meta)
+}
+
+func resourceLinodeInstanceIPDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	linodeID := d.Get("linode_id").(int)
+
+	if err := client.DeleteInstanceIPAddress(context.Background(), linodeID, d.Id()); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Error deleting Linode Instance %d IP %s: %s", linodeID, d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// rebootInstanceForIP reboots a running Linode Instance so that Network Helper configures its new IP
+func rebootInstanceForIP(client linodego.Client, linodeID int, d *schema.ResourceData) error {
+	instance, err := client.GetInstance(context.Background(), linodeID)
+	if err != nil {
+		return fmt.Errorf("Error fetching data about Linode Instance %d: %s", linodeID, err)
+	}
+	if !isInstanceBooted(instance) {
+		log.Printf("[INFO] Not rebooting Linode Instance %d for IP %s because it is not running", linodeID, d.Id())
+		return nil
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the reboot event
+	minStart := time.Now().Add(-time.Minute)
+	if err = client.RebootInstance(context.Background(), linodeID, 0); err != nil {
+		return fmt.Errorf("Error rebooting Linode Instance %d to apply IP %s: %s", linodeID, d.Id(), err)
+	}
+
+	if _, err = client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionLinodeReboot, minStart, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d to finish rebooting: %s", linodeID, err)
+	}
+
+	if _, err = client.WaitForInstanceStatus(context.Background(), linodeID, linodego.InstanceRunning, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode Instance %d to boot: %s", linodeID, err)
+	}
+	return nil
+}
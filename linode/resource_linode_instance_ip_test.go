@@ -0,0 +1,132 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeInstanceIP_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_ip.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceIPDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceIPConfigBasic(instanceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceIPExists,
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resName, "address"),
+					resource.TestCheckResourceAttrSet(resName, "gateway"),
+					resource.TestCheckResourceAttr(resName, "public", "true"),
+					resource.TestCheckResourceAttr(resName, "type", "ipv4"),
+					resource.TestCheckResourceAttr(resName, "prefix", "24"),
+					resource.TestCheckResourceAttr(resName, "region", "us-east"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccStateIDInstanceIP,
+				ImportStateVerifyIgnore: []string{"apply_immediately"},
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceIPExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_ip" {
+			continue
+		}
+
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		_, err = client.GetInstanceIPAddress(context.Background(), linodeID, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Instance %d IP %s: %s", linodeID, rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeInstanceIPDestroy(s *terraform.State) error {
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Error getting Linode client")
+	}
+	client := providerMeta.Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_ip" {
+			continue
+		}
+
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		_, err = client.GetInstanceIPAddress(context.Background(), linodeID, rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Linode Instance %d IP %s still exists", linodeID, rs.Primary.ID)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Error requesting Linode Instance %d IP %s", linodeID, rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccStateIDInstanceIP(s *terraform.State) (string, error) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_ip" {
+			continue
+		}
+
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return "", fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+		return fmt.Sprintf("%d,%s", linodeID, rs.Primary.ID), nil
+	}
+
+	return "", fmt.Errorf("Error finding linode_instance_ip")
+}
+
+func testAccCheckLinodeInstanceIPConfigBasic(instance string, public bool) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance_ip" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	public = %t
+}`, instance, public)
+}
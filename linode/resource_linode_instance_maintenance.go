@@ -0,0 +1,163 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/linode/linodego"
+)
+
+// Account notification types announcing maintenance of a Linode Instance
+var (
+	instanceMigrationNotificationTypes = []string{"migration_scheduled", "migration_imminent", "migration_pending"}
+	instanceUpgradeNotificationTypes   = []string{"upgrade_pending"}
+)
+
+func resourceLinodeInstanceMaintenance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceMaintenanceCreate,
+		Read:   resourceLinodeInstanceMaintenanceRead,
+		Delete: resourceLinodeInstanceMaintenanceDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to apply pending maintenance to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that, when changed, apply the pending maintenance of the Linode Instance again.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"migrate": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, a pending or scheduled migration of the Linode Instance is applied.",
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+			"upgrade": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, a pending upgrade of the Linode Instance is applied.",
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+			"pending_migration": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, the account notifications announce a migration of the Linode Instance.",
+				Computed:    true,
+			},
+			"pending_upgrade": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "If true, the account notifications announce an upgrade of the Linode Instance.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceMaintenanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	linodeID := d.Get("linode_id").(int)
+
+	if _, err := client.GetInstance(context.Background(), linodeID); err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance Maintenance %q from state because Linode Instance %d no longer exists", d.Id(), linodeID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the Linode Instance %d: %s", linodeID, err)
+	}
+
+	pendingMigration, pendingUpgrade, err := getInstanceMaintenance(client, linodeID)
+	if err != nil {
+		return err
+	}
+
+	d.Set("pending_migration", pendingMigration)
+	d.Set("pending_upgrade", pendingUpgrade)
+
+	return nil
+}
+
+func resourceLinodeInstanceMaintenanceCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Maintenance")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+
+	pendingMigration, pendingUpgrade, err := getInstanceMaintenance(client, linodeID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(linodeID))
+
+	if pendingMigration && d.Get("migrate").(bool) {
+		// allow for clock skew between the API and Terraform when looking for the migration event
+		minStart := time.Now().Add(-time.Minute)
+		if err := client.MigrateInstance(context.Background(), linodeID); err != nil {
+			return fmt.Errorf("Error migrating Linode Instance %d: %s", linodeID, err)
+		}
+		if _, err := client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionLinodeMigrate, minStart, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+			return fmt.Errorf("Error waiting for Linode Instance %d to finish migrating: %s", linodeID, err)
+		}
+	} else if pendingMigration {
+		log.Printf("[INFO] Not migrating Linode Instance %d because migrate is false", linodeID)
+	}
+
+	if pendingUpgrade && d.Get("upgrade").(bool) {
+		minStart := time.Now().Add(-time.Minute)
+		if err := client.MutateInstance(context.Background(), linodeID); err != nil {
+			return fmt.Errorf("Error upgrading Linode Instance %d: %s", linodeID, err)
+		}
+		if _, err := client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionLinodeMutate, minStart, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+			return fmt.Errorf("Error waiting for Linode Instance %d to finish upgrading: %s", linodeID, err)
+		}
+	} else if pendingUpgrade {
+		log.Printf("[INFO] Not upgrading Linode Instance %d because upgrade is false", linodeID)
+	}
+
+	if !pendingMigration && !pendingUpgrade {
+		log.Printf("[INFO] Linode Instance %d has no pending maintenance", linodeID)
+	}
+
+	return resourceLinodeInstanceMaintenanceRead(d, meta)
+}
+
+func resourceLinodeInstanceMaintenanceDelete(d *schema.ResourceData, meta interface{}) error {
+	// Maintenance can not be undone, destroying it only removes it from state
+	d.SetId("")
+	return nil
+}
+
+// getInstanceMaintenance returns whether the account notifications announce a migration or upgrade of the Linode Instance
+func getInstanceMaintenance(client linodego.Client, linodeID int) (pendingMigration bool, pendingUpgrade bool, err error) {
+	notifications, err := client.ListNotifications(context.Background(), nil)
+	if err != nil {
+		return false, false, fmt.Errorf("Error listing account notifications: %s", err)
+	}
+
+	for _, notification := range notifications {
+		if notification.Entity == nil || notification.Entity.Type != "linode" || notification.Entity.ID != linodeID {
+			continue
+		}
+		pendingMigration = pendingMigration || stringInSlice(notification.Type, instanceMigrationNotificationTypes)
+		pendingUpgrade = pendingUpgrade || stringInSlice(notification.Type, instanceUpgradeNotificationTypes)
+	}
+
+	return pendingMigration, pendingUpgrade, nil
+}
@@ -0,0 +1,58 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLinodeInstanceMaintenance_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_maintenance.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceMaintenanceConfigBasic(instanceName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "triggers.window", "1"),
+					resource.TestCheckResourceAttr(resName, "pending_migration", "false"),
+					resource.TestCheckResourceAttr(resName, "pending_upgrade", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceMaintenanceConfigBasic(instanceName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "triggers.window", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceMaintenanceConfigBasic(instance string, window string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance_maintenance" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+
+	triggers {
+		window = "%s"
+	}
+}`, instance, window)
+}
@@ -0,0 +1,155 @@
+package linode
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceLinodeInstanceMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	var err error
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Linode Instance State v0; migrating to v1")
+		if is, err = migrateLinodeInstanceStateV0toV1(is); err != nil {
+			return is, err
+		}
+		fallthrough
+	case 1:
+		log.Println("[INFO] Found Linode Instance State v1; migrating to v2")
+		return migrateLinodeInstanceStateV1toV2(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// v0 config devices were fixed blocks, config.N.devices.0.sda.0.disk_id
+// v1 config devices are a list keyed by slot, config.N.device.M.slot = "sda"
+var instanceStateV0DeviceAttr = regexp.MustCompile(`^config\.(\d+)\.devices\.0\.([a-z0-9]+)\.0\.(disk_label|disk_id|volume_id)$`)
+
+func migrateLinodeInstanceStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty Linode Instance State; nothing to migrate.")
+		return is, nil
+	}
+
+	// config index => slot => device attributes
+	configDevices := make(map[string]map[string]map[string]string)
+	for k, v := range is.Attributes {
+		if match := instanceStateV0DeviceAttr.FindStringSubmatch(k); match != nil {
+			config, slot, attr := match[1], match[2], match[3]
+			if _, ok := configDevices[config]; !ok {
+				configDevices[config] = make(map[string]map[string]string)
+			}
+			if _, ok := configDevices[config][slot]; !ok {
+				configDevices[config][slot] = make(map[string]string)
+			}
+			configDevices[config][slot][attr] = v
+		}
+	}
+
+	for k := range is.Attributes {
+		if strings.HasPrefix(k, "config.") && strings.Contains(k, ".devices.") {
+			delete(is.Attributes, k)
+		}
+	}
+
+	configCount, _ := strconv.Atoi(is.Attributes["config.#"])
+	for i := 0; i < configCount; i++ {
+		config := strconv.Itoa(i)
+		devices := configDevices[config]
+
+		slots := make([]string, 0, len(devices))
+		for slot, attrs := range devices {
+			if emptyInstanceStateV0Device(attrs) {
+				continue
+			}
+			slots = append(slots, slot)
+		}
+		sort.Strings(slots)
+
+		is.Attributes[fmt.Sprintf("config.%s.device.#", config)] = strconv.Itoa(len(slots))
+		for j, slot := range slots {
+			prefix := fmt.Sprintf("config.%s.device.%d.", config, j)
+			is.Attributes[prefix+"slot"] = slot
+			for _, attr := range []string{"disk_label", "disk_id", "volume_id"} {
+				value, ok := devices[slot][attr]
+				if !ok {
+					if attr == "disk_label" {
+						value = ""
+					} else {
+						value = "0"
+					}
+				}
+				is.Attributes[prefix+attr] = value
+			}
+		}
+	}
+
+	return is, nil
+}
+
+// emptyInstanceStateV0Device returns true when a v0 device slot has neither a disk or volume assigned
+func emptyInstanceStateV0Device(attrs map[string]string) bool {
+	for _, attr := range []string{"disk_id", "volume_id"} {
+		if value, ok := attrs[attr]; ok && value != "" && value != "0" {
+			return false
+		}
+	}
+	return attrs["disk_label"] == ""
+}
+
+// v1 disks and configs were lists, disk.N.label
+// v2 disks and configs are sets keyed by label, disk.<labelHashcode>.label
+var instanceStateV1SetAttr = regexp.MustCompile(`^(disk|config)\.(\d+)\.(.+)$`)
+
+func migrateLinodeInstanceStateV1toV2(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty Linode Instance State; nothing to migrate.")
+		return is, nil
+	}
+
+	// disk or config => list index => set hash
+	hashes := map[string]map[string]int{
+		"disk":   make(map[string]int),
+		"config": make(map[string]int),
+	}
+	for k, v := range is.Attributes {
+		if match := instanceStateV1SetAttr.FindStringSubmatch(k); match != nil && match[3] == "label" {
+			kind, index := match[1], match[2]
+			hash := labelHashcode(v)
+			if kind == "config" {
+				hash = configHashcode(v)
+			}
+			for otherIndex, otherHash := range hashes[kind] {
+				if otherHash == hash && otherIndex != index {
+					return is, fmt.Errorf("Error migrating Linode Instance %s state: label '%s' is assigned to multiple %ss", is.ID, v, kind)
+				}
+			}
+			hashes[kind][index] = hash
+		}
+	}
+
+	migrated := make(map[string]string, len(is.Attributes))
+	for k, v := range is.Attributes {
+		match := instanceStateV1SetAttr.FindStringSubmatch(k)
+		if match == nil {
+			migrated[k] = v
+			continue
+		}
+		kind, index, attr := match[1], match[2], match[3]
+		hash, ok := hashes[kind][index]
+		if !ok {
+			return is, fmt.Errorf("Error migrating Linode Instance %s state: %s %s has no label", is.ID, kind, index)
+		}
+		migrated[fmt.Sprintf("%s.%d.%s", kind, hash, attr)] = v
+	}
+	is.Attributes = migrated
+
+	return is, nil
+}
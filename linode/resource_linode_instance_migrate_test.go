@@ -0,0 +1,168 @@
+package linode
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestLinodeInstanceMigrateState(t *testing.T) {
+	config := fmt.Sprintf("config.%d.", configHashcode("config"))
+	configb := fmt.Sprintf("config.%d.", configHashcode("configb"))
+	disk := fmt.Sprintf("disk.%d.", labelHashcode("disk"))
+	diskb := fmt.Sprintf("disk.%d.", labelHashcode("diskb"))
+
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_2_no_config": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"label":    "foobar",
+				"config.#": "0",
+			},
+			Expected: map[string]string{
+				"label":    "foobar",
+				"config.#": "0",
+			},
+		},
+		"v0_2_config_devices": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"config.#":                            "1",
+				"config.0.label":                      "config",
+				"config.0.devices.#":                  "1",
+				"config.0.devices.0.sda.#":            "1",
+				"config.0.devices.0.sda.0.disk_id":    "123",
+				"config.0.devices.0.sda.0.disk_label": "boot",
+				"config.0.devices.0.sda.0.volume_id":  "0",
+				"config.0.devices.0.sdb.#":            "1",
+				"config.0.devices.0.sdb.0.disk_id":    "0",
+				"config.0.devices.0.sdb.0.volume_id":  "456",
+				"config.0.devices.0.sdc.#":            "0",
+			},
+			Expected: map[string]string{
+				"config.#":                     "1",
+				config + "label":               "config",
+				config + "device.#":            "2",
+				config + "device.0.slot":       "sda",
+				config + "device.0.disk_id":    "123",
+				config + "device.0.disk_label": "boot",
+				config + "device.0.volume_id":  "0",
+				config + "device.1.slot":       "sdb",
+				config + "device.1.disk_id":    "0",
+				config + "device.1.disk_label": "",
+				config + "device.1.volume_id":  "456",
+			},
+		},
+		"v0_2_empty_devices": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"config.#":                 "1",
+				"config.0.label":           "config",
+				"config.0.devices.#":       "1",
+				"config.0.devices.0.sda.#": "0",
+			},
+			Expected: map[string]string{
+				"config.#":          "1",
+				config + "label":    "config",
+				config + "device.#": "0",
+			},
+		},
+		"v1_2_disks_and_configs": {
+			StateVersion: 1,
+			Attributes: map[string]string{
+				"label":                        "foobar",
+				"disk.#":                       "2",
+				"disk.0.label":                 "diskb",
+				"disk.0.id":                    "2",
+				"disk.0.size":                  "512",
+				"disk.0.authorized_keys.#":     "0",
+				"disk.1.label":                 "disk",
+				"disk.1.id":                    "1",
+				"disk.1.size":                  "3000",
+				"config.#":                     "2",
+				"config.0.label":               "configb",
+				"config.0.device.#":            "1",
+				"config.0.device.0.slot":       "sda",
+				"config.0.device.0.disk_label": "diskb",
+				"config.1.label":               "config",
+				"config.1.helpers.#":           "1",
+				"config.1.helpers.0.network":   "true",
+				"config.1.device.#":            "0",
+			},
+			Expected: map[string]string{
+				"label":                         "foobar",
+				"disk.#":                        "2",
+				diskb + "label":                 "diskb",
+				diskb + "id":                    "2",
+				diskb + "size":                  "512",
+				diskb + "authorized_keys.#":     "0",
+				disk + "label":                  "disk",
+				disk + "id":                     "1",
+				disk + "size":                   "3000",
+				"config.#":                      "2",
+				configb + "label":               "configb",
+				configb + "device.#":            "1",
+				configb + "device.0.slot":       "sda",
+				configb + "device.0.disk_label": "diskb",
+				config + "label":                "config",
+				config + "helpers.#":            "1",
+				config + "helpers.0.network":    "true",
+				config + "device.#":             "0",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "1234",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceLinodeInstanceMigrateState(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", name, err)
+		}
+
+		if !reflect.DeepEqual(is.Attributes, tc.Expected) {
+			t.Fatalf("bad: %s\n\n expected: %#v\n got: %#v", name, tc.Expected, is.Attributes)
+		}
+	}
+}
+
+func TestLinodeInstanceMigrateState_duplicateLabels(t *testing.T) {
+	is := &terraform.InstanceState{
+		ID: "1234",
+		Attributes: map[string]string{
+			"disk.#":       "2",
+			"disk.0.label": "disk",
+			"disk.1.label": "disk",
+		},
+	}
+	if _, err := resourceLinodeInstanceMigrateState(1, is, nil); err == nil {
+		t.Fatal("expected an error migrating disks with duplicate labels")
+	}
+}
+
+func TestLinodeInstanceMigrateState_empty(t *testing.T) {
+	var is *terraform.InstanceState
+
+	// should handle nil
+	is, err := resourceLinodeInstanceMigrateState(0, is, nil)
+	if err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+	if is != nil {
+		t.Fatalf("expected nil instancestate, got: %#v", is)
+	}
+
+	// should handle non-nil but empty
+	is = &terraform.InstanceState{}
+	if _, err = resourceLinodeInstanceMigrateState(0, is, nil); err != nil {
+		t.Fatalf("err: %#v", err)
+	}
+}
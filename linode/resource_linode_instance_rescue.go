@@ -0,0 +1,168 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+// instanceRescueDeviceSlots are the device slots available in rescue mode, the last slot holds the rescue image
+var instanceRescueDeviceSlots = instanceConfigDeviceSlots[:len(instanceConfigDeviceSlots)-1]
+
+func resourceLinodeInstanceRescue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceRescueCreate,
+		Read:   resourceLinodeInstanceRescueRead,
+		Update: resourceLinodeInstanceRescueUpdate,
+		Delete: resourceLinodeInstanceRescueDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to boot into rescue mode.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"device": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The Disks and Volumes mapped to the device slots of the rescue environment, in slot order.",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    len(instanceRescueDeviceSlots),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"slot": {
+							Type:         schema.TypeString,
+							Description:  "The device slot to map the Disk or Volume to (sda, sdb, ...).",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(instanceRescueDeviceSlots, false),
+						},
+						"disk_id": {
+							Type:        schema.TypeInt,
+							Description: "The Disk ID to map to this device slot.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"volume_id": {
+							Type:        schema.TypeInt,
+							Description: "The Block Storage volume ID to map to this device slot.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"config_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Config to reboot the Linode Instance into when leaving rescue mode. Defaults to the Config the Linode Instance was last booted into.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceRescueRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	linodeID := d.Get("linode_id").(int)
+
+	// Rescue mode can not be read back, it is kept in state for as long as its Linode Instance exists
+	if _, err := client.GetInstance(context.Background(), linodeID); err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance Rescue %q from state because Linode Instance %d no longer exists", d.Id(), linodeID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the Linode Instance %d in rescue mode: %s", linodeID, err)
+	}
+
+	return nil
+}
+
+func resourceLinodeInstanceRescueCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Rescue")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+
+	rescueOpts := linodego.RescueInstanceOptions{}
+	deviceMap, err := expandInstanceConfigDeviceMap(d.Get("device").([]interface{}), nil)
+	if err != nil {
+		return err
+	}
+	if deviceMap != nil {
+		rescueOpts.Devices = *deviceMap
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the boot event
+	minStart := time.Now().Add(-time.Minute)
+	if err := client.RescueInstance(context.Background(), linodeID, rescueOpts); err != nil {
+		return fmt.Errorf("Error booting Linode Instance %d into rescue mode: %s", linodeID, err)
+	}
+
+	d.SetId(strconv.Itoa(linodeID))
+
+	if _, err := client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionLinodeBoot, minStart, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d to boot into rescue mode: %s", linodeID, err)
+	}
+	if _, err := client.WaitForInstanceStatus(context.Background(), linodeID, linodego.InstanceRunning, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode Instance %d to boot into rescue mode: %s", linodeID, err)
+	}
+
+	return resourceLinodeInstanceRescueRead(d, meta)
+}
+
+func resourceLinodeInstanceRescueUpdate(d *schema.ResourceData, meta interface{}) error {
+	// config_id is only used when leaving rescue mode
+	return resourceLinodeInstanceRescueRead(d, meta)
+}
+
+func resourceLinodeInstanceRescueDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	linodeID := d.Get("linode_id").(int)
+	configID := d.Get("config_id").(int)
+
+	instance, err := client.GetInstance(context.Background(), linodeID)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode Instance %d: %s", linodeID, err)
+	}
+
+	// an instance that has been shut down since has already left rescue mode, and is left powered off
+	if instance.Status != linodego.InstanceRunning {
+		log.Printf("[INFO] Linode Instance %d is %s, not rebooting it out of rescue mode", linodeID, instance.Status)
+		d.SetId("")
+		return nil
+	}
+
+	// allow for clock skew between the API and Terraform when looking for the reboot event
+	minStart := time.Now().Add(-time.Minute)
+	if err := client.RebootInstance(context.Background(), linodeID, configID); err != nil {
+		return fmt.Errorf("Error rebooting Linode Instance %d out of rescue mode: %s", linodeID, err)
+	}
+
+	if _, err := client.WaitForEventFinished(context.Background(), linodeID, linodego.EntityLinode, linodego.ActionLinodeReboot, minStart, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d to reboot out of rescue mode: %s", linodeID, err)
+	}
+	if _, err := client.WaitForInstanceStatus(context.Background(), linodeID, linodego.InstanceRunning, int(d.Timeout(schema.TimeoutDelete).Seconds())); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode Instance %d to reboot out of rescue mode: %s", linodeID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,89 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeInstanceRescue_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_rescue.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceRescueConfigBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceRescueRunning,
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "device.0.slot", "sda"),
+					resource.TestCheckResourceAttrPair(resName, "device.0.disk_id", "linode_instance_disk.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceRescueRunning(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_rescue" {
+			continue
+		}
+
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		instance, err := client.GetInstance(context.Background(), linodeID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Linode Instance %d: %s", linodeID, err)
+		}
+		if instance.Status != linodego.InstanceRunning {
+			return fmt.Errorf("Expected Linode Instance %d to be running in rescue mode, got %s", linodeID, instance.Status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeInstanceRescueConfigBasic(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance_disk" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "data"
+	size = 1024
+	filesystem = "ext4"
+}
+
+resource "linode_instance_rescue" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+
+	device {
+		slot = "sda"
+		disk_id = "${linode_instance_disk.foobar.id}"
+	}
+}`, instance)
+}
@@ -0,0 +1,131 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeInstanceSharedIPs() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceSharedIPsCreate,
+		Read:   resourceLinodeInstanceSharedIPsRead,
+		Update: resourceLinodeInstanceSharedIPsUpdate,
+		Delete: resourceLinodeInstanceSharedIPsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to share the IPv4 addresses with.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"addresses": &schema.Schema{
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.SingleIP(),
+				},
+				Set:         schema.HashString,
+				Description: "The IPv4 addresses of other Linode Instances that this Linode Instance may bring up.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceSharedIPsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	linodeID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance ID %s as int: %s", d.Id(), err)
+	}
+
+	ips, err := client.GetInstanceIPAddresses(context.Background(), linodeID)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance Shared IPs %q from state because Linode Instance %d no longer exists", d.Id(), linodeID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the IPs of Linode Instance %d: %s", linodeID, err)
+	}
+
+	var addresses []string
+	if ips.IPv4 != nil {
+		for _, ip := range ips.IPv4.Shared {
+			addresses = append(addresses, ip.Address)
+		}
+	}
+
+	d.Set("linode_id", linodeID)
+	d.Set("addresses", addresses)
+
+	return nil
+}
+
+func resourceLinodeInstanceSharedIPsCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Shared IPs")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+	if err := shareInstanceIPs(client, linodeID, d.Get("addresses").(*schema.Set)); err != nil {
+		return err
+	}
+	d.SetId(strconv.Itoa(linodeID))
+
+	return resourceLinodeInstanceSharedIPsRead(d, meta)
+}
+
+func resourceLinodeInstanceSharedIPsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	if d.HasChange("addresses") {
+		if err := shareInstanceIPs(client, d.Get("linode_id").(int), d.Get("addresses").(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
+	return resourceLinodeInstanceSharedIPsRead(d, meta)
+}
+
+func resourceLinodeInstanceSharedIPsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	linodeID := d.Get("linode_id").(int)
+
+	shareOpts := linodego.IPAddressesShareOptions{LinodeID: linodeID, IPs: []string{}}
+	if err := client.ShareIPAddresses(context.Background(), shareOpts); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Error unsharing IPs with Linode Instance %d: %s", linodeID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// shareInstanceIPs replaces the IPv4 addresses shared with a Linode Instance
+func shareInstanceIPs(client linodego.Client, linodeID int, addresses *schema.Set) error {
+	shareOpts := linodego.IPAddressesShareOptions{
+		LinodeID: linodeID,
+		IPs:      make([]string, 0, addresses.Len()),
+	}
+	for _, address := range addresses.List() {
+		shareOpts.IPs = append(shareOpts.IPs, address.(string))
+	}
+
+	if err := client.ShareIPAddresses(context.Background(), shareOpts); err != nil {
+		return fmt.Errorf("Error sharing IPs with Linode Instance %d: %s", linodeID, err)
+	}
+	return nil
+}
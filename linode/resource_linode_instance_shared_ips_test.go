@@ -0,0 +1,60 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLinodeInstanceSharedIPs_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_shared_ips.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceSharedIPsConfigBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.standby", "id"),
+					resource.TestCheckResourceAttr(resName, "addresses.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceSharedIPsConfigBasic(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "primary" {
+	label = "%s_primary"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance" "standby" {
+	label = "%s_standby"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance_shared_ips" "foobar" {
+	linode_id = "${linode_instance.standby.id}"
+	addresses = ["${linode_instance.primary.ip_address}"]
+}`, instance, instance)
+}
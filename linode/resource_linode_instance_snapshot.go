@@ -0,0 +1,228 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeInstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceSnapshotCreate,
+		Read:   resourceLinodeInstanceSnapshotRead,
+		Delete: resourceLinodeInstanceSnapshotDelete,
+		Exists: resourceLinodeInstanceSnapshotExists,
+		Importer: &schema.ResourceImporter{
+			State: resourceLinodeInstanceSnapshotImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to snapshot.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The label of the Snapshot.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The current status of the Snapshot.",
+				Computed:    true,
+			},
+			"created": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the Snapshot was created.",
+				Computed:    true,
+			},
+			"finished": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the Snapshot finished.",
+				Computed:    true,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The region of the Linode Instance the Snapshot was taken from.",
+				Computed:    true,
+			},
+			"configs": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels of the Configs included in the Snapshot.",
+				Computed:    true,
+			},
+			"disks": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The Disks included in the Snapshot.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The label of the source Disk.",
+							Computed:    true,
+						},
+						"size": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "The size of the source Disk in MB.",
+							Computed:    true,
+						},
+						"filesystem": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The filesystem of the source Disk.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLinodeInstanceSnapshotExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing Linode Instance Snapshot ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	_, err = client.GetInstanceSnapshot(context.Background(), linodeID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error getting Linode Instance %d Snapshot %s: %s", linodeID, d.Id(), err)
+	}
+	return true, nil
+}
+
+func resourceLinodeInstanceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Error parsing Linode Instance Snapshot ID %s as int: %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	snapshot, err := client.GetInstanceSnapshot(context.Background(), linodeID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Instance %d Snapshot %q from state because it no longer exists", linodeID, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode Instance %d Snapshot: %s", linodeID, err)
+	}
+
+	instance, err := client.GetInstance(context.Background(), linodeID)
+	if err != nil {
+		return fmt.Errorf("Error finding the Linode Instance %d of Snapshot %d: %s", linodeID, snapshot.ID, err)
+	}
+
+	d.Set("label", snapshot.Label)
+	d.Set("status", snapshot.Status)
+	d.Set("region", instance.Region)
+	d.Set("configs", snapshot.Configs)
+
+	if snapshot.Created != nil {
+		d.Set("created", snapshot.Created.Format(time.RFC3339))
+	}
+	if snapshot.Finished != nil {
+		d.Set("finished", snapshot.Finished.Format(time.RFC3339))
+	}
+
+	if err := d.Set("disks", flattenInstanceSnapshotDisks(snapshot.Disks)); err != nil {
+		return fmt.Errorf("Error setting Linode Instance Snapshot disks: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLinodeInstanceSnapshotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		// Validate that this is an ID by making sure it can be converted into an int
+		_, err := strconv.Atoi(s[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid snapshot ID: %v", err)
+		}
+
+		linodeID, err := strconv.Atoi(s[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid linode ID: %v", err)
+		}
+
+		d.SetId(s[1])
+		d.Set("linode_id", linodeID)
+	}
+
+	err := resourceLinodeInstanceSnapshotRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import %v as instance_snapshot: %v", d.Id(), err)
+	}
+
+	results := make([]*schema.ResourceData, 0)
+	results = append(results, d)
+
+	return results, nil
+}
+
+func resourceLinodeInstanceSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Snapshot")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+	snapshot, err := client.CreateInstanceSnapshot(context.Background(), linodeID, d.Get("label").(string))
+	if err != nil {
+		return fmt.Errorf("Error creating a Linode Instance %d Snapshot: %s", linodeID, err)
+	}
+	d.SetId(fmt.Sprintf("%d", snapshot.ID))
+
+	if _, err = client.WaitForSnapshotStatus(context.Background(), linodeID, snapshot.ID, linodego.SnapshotSuccessful, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return fmt.Errorf("Error waiting for Linode Instance %d Snapshot %d to succeed: %s", linodeID, snapshot.ID, err)
+	}
+
+	return resourceLinodeInstanceSnapshotRead(d, meta)
+}
+
+func resourceLinodeInstanceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	// Snapshots can not be deleted through the Linode API, they are replaced by the next
+	// Snapshot of the Linode Instance and removed when its Backups are cancelled.
+	log.Printf("[INFO] removing Linode Instance %d Snapshot %s from state, the Snapshot is retained until it is replaced", d.Get("linode_id").(int), d.Id())
+	d.SetId("")
+	return nil
+}
+
+// flattenInstanceSnapshotDisks converts the Disks of an Instance Snapshot to a list of maps
+func flattenInstanceSnapshotDisks(disks []*linodego.InstanceSnapshotDisk) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(disks))
+	for _, disk := range disks {
+		if disk == nil {
+			continue
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"label":      disk.Label,
+			"size":       disk.Size,
+			"filesystem": disk.Filesystem,
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,146 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeInstanceSnapshot_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_snapshot.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+	snapshotName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceSnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceSnapshotConfigBasic(instanceName, snapshotName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceSnapshotExists,
+					resource.TestCheckResourceAttr(resName, "label", snapshotName),
+					resource.TestCheckResourceAttr(resName, "status", "successful"),
+					resource.TestCheckResourceAttr(resName, "region", "us-east"),
+					resource.TestCheckResourceAttr(resName, "disks.#", "2"),
+					resource.TestCheckResourceAttrSet(resName, "created"),
+					resource.TestCheckResourceAttrSet(resName, "disks.0.size"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStateIDInstanceSnapshot,
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceSnapshotExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_snapshot" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		_, err = client.GetInstanceSnapshot(context.Background(), linodeID, id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Instance %d Snapshot %s: %s", linodeID, rs.Primary.Attributes["label"], err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeInstanceSnapshotDestroy(s *terraform.State) error {
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Error getting Linode client")
+	}
+	client := providerMeta.Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_snapshot" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+
+		// Snapshots are removed along with their Linode Instance
+		_, err = client.GetInstanceSnapshot(context.Background(), linodeID, id)
+
+		if err == nil {
+			return fmt.Errorf("Linode Instance %d Snapshot %d still exists", linodeID, id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Error requesting Linode Instance %d Snapshot %d", linodeID, id)
+		}
+	}
+
+	return nil
+}
+
+func testAccStateIDInstanceSnapshot(s *terraform.State) (string, error) {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_instance_snapshot" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return "", fmt.Errorf("Error parsing ID %v to int", rs.Primary.ID)
+		}
+		linodeID, err := strconv.Atoi(rs.Primary.Attributes["linode_id"])
+		if err != nil {
+			return "", fmt.Errorf("Error parsing linode_id %v to int", rs.Primary.Attributes["linode_id"])
+		}
+		return fmt.Sprintf("%d,%d", linodeID, id), nil
+	}
+
+	return "", fmt.Errorf("Error finding linode_instance_snapshot")
+}
+
+func testAccCheckLinodeInstanceSnapshotConfigBasic(instance string, snapshot string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	backups_enabled = true
+}
+
+resource "linode_instance_snapshot" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "%s"
+}`, instance, snapshot)
+}
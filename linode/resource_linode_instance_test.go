@@ -3,12 +3,14 @@ package linode
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/linode/linodego"
 )
@@ -39,6 +41,7 @@ func TestAccLinodeInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "region", "us-east"),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "256"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
 				),
 			},
 
@@ -79,7 +82,7 @@ func TestAccLinodeInstance_config(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "group", "tf_test"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					resource.TestCheckResourceAttr(resName, "alerts.0.cpu", "60"),
-					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.network", "true"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "helpers.0.network"), "true"),
 					testAccCheckComputeInstanceConfigs(&instance, testConfig("config", testConfigKernel("linode/latest-64bit"))),
 				),
 			},
@@ -159,8 +162,8 @@ func TestAccLinodeInstance_disk(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "status", "offline"),
 					resource.TestCheckResourceAttr(resName, "config.#", "0"),
 					resource.TestCheckResourceAttr(resName, "disk.#", "1"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "3000"),
-					resource.TestCheckResourceAttr(resName, "disk.0.label", "disk"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "3000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "label"), "disk"),
 					testAccCheckComputeInstanceDisk(&instance, "disk", 3000),
 				),
 			},
@@ -201,7 +204,7 @@ func TestAccLinodeInstance_diskImage(t *testing.T) {
 					// resource.TestCheckResourceAttr(resName, "kernel", "linode/latest-64bit"),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "3000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "3000"),
 					testAccCheckComputeInstanceDisk(&instance, "disk", 3000),
 				),
 			},
@@ -430,6 +433,7 @@ func TestAccLinodeInstance_updateSimple(t *testing.T) {
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					resource.TestCheckResourceAttr(resName, "label", fmt.Sprintf("%s_r", instanceName)),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test_r"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
 				),
 			},
 		},
@@ -457,9 +461,9 @@ func TestAccLinodeInstance_configUpdate(t *testing.T) {
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					resource.TestCheckResourceAttr(resName, "label", instanceName),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test"),
-					resource.TestCheckResourceAttr(resName, "config.0.kernel", "linode/latest-64bit"),
-					resource.TestCheckResourceAttr(resName, "config.0.root_device", "/dev/root"),
-					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.network", "true"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "kernel"), "linode/latest-64bit"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "root_device"), "/dev/root"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "helpers.0.network"), "true"),
 					resource.TestCheckResourceAttr(resName, "alerts.0.cpu", "60"),
 				),
 			},
@@ -470,10 +474,10 @@ func TestAccLinodeInstance_configUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "label", fmt.Sprintf("%s_r", instanceName)),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test_r"),
 					// changed kerel, not label
-					resource.TestCheckResourceAttr(resName, "config.0.label", "config"),
-					resource.TestCheckResourceAttr(resName, "config.0.kernel", "linode/latest-32bit"),
-					resource.TestCheckResourceAttr(resName, "config.0.root_device", "/dev/root"),
-					resource.TestCheckResourceAttr(resName, "config.0.helpers.0.network", "false"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "label"), "config"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "kernel"), "linode/latest-32bit"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "root_device"), "/dev/root"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "helpers.0.network"), "false"),
 					resource.TestCheckResourceAttr(resName, "alerts.0.cpu", "80"),
 				),
 			},
@@ -527,8 +531,8 @@ func TestAccLinodeInstance_configPairUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "label", instanceName),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test"),
 					resource.TestCheckResourceAttr(resName, "config.#", "1"),
-					resource.TestCheckResourceAttr(resName, "config.0.label", "config"),
-					resource.TestCheckResourceAttr(resName, "config.0.kernel", "linode/latest-64bit"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "label"), "config"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "kernel"), "linode/latest-64bit"),
 					testAccCheckComputeInstanceConfigs(&instance,
 						testConfig("config", testConfigExists(&config), testConfigKernel("linode/latest-64bit")),
 					),
@@ -543,10 +547,10 @@ func TestAccLinodeInstance_configPairUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "region", "us-east"),
 					// resource.TestCheckResourceAttr(resName, "kernel", "linode/latest-64bit"),
 					resource.TestCheckResourceAttr(resName, "config.#", "2"),
-					resource.TestCheckResourceAttr(resName, "config.0.label", "configa"),
-					resource.TestCheckResourceAttr(resName, "config.0.kernel", "linode/latest-64bit"),
-					resource.TestCheckResourceAttr(resName, "config.1.label", "configb"),
-					resource.TestCheckResourceAttr(resName, "config.1.kernel", "linode/latest-32bit"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("configa", "label"), "configa"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("configa", "kernel"), "linode/latest-64bit"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("configb", "label"), "configb"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("configb", "kernel"), "linode/latest-32bit"),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					testAccCheckComputeInstanceConfigs(&instance,
@@ -568,8 +572,8 @@ func TestAccLinodeInstance_configPairUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "label", instanceName),
 					resource.TestCheckResourceAttr(resName, "group", "tf_test"),
 					resource.TestCheckResourceAttr(resName, "config.#", "1"),
-					resource.TestCheckResourceAttr(resName, "config.0.label", "config"),
-					resource.TestCheckResourceAttr(resName, "config.0.kernel", "linode/latest-64bit"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "label"), "config"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "kernel"), "linode/latest-64bit"),
 					testAccCheckComputeInstanceConfigs(&instance,
 						testConfig("config", testConfigExists(&config), testConfigKernel("linode/latest-64bit")),
 					),
@@ -648,6 +652,97 @@ func TestAccLinodeInstance_resize(t *testing.T) {
 	})
 }
 
+func TestAccLinodeInstance_resizeDisk(t *testing.T) {
+	t.Parallel()
+	var instance linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Error generating test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			// Start off with a Linode 1024
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigUpsizeSmall(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					testAccCheckLinodeInstanceTotalDiskSize(&instance, 25600),
+				),
+			},
+			// Bump it to a 2048, growing the disk with it
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigUpsizeExpandDisk(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "specs.0.disk", "51200"),
+					resource.TestCheckResourceAttr(resName, "type", "g6-standard-1"),
+					testAccCheckLinodeInstanceTotalDiskSize(&instance, 51200),
+				),
+			},
+			// Going back down to a 1024 without resize_disk no longer fits the disks
+			resource.TestStep{
+				Config:      testAccCheckLinodeInstanceConfigUpsizeSmall(instanceName, publicKeyMaterial),
+				ExpectError: regexp.MustCompile("set resize_disk to shrink its largest disk"),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_migrateRegion(t *testing.T) {
+	t.Parallel()
+	var instance linodego.Instance
+	var instanceID int
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	resName := "linode_instance.foobar"
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Error generating test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceMigrateRegion(instanceName, publicKeyMaterial, "us-east"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					func(*terraform.State) error {
+						instanceID = instance.ID
+						return nil
+					},
+					resource.TestCheckResourceAttr(resName, "region", "us-east"),
+				),
+			},
+			// The Linode Instance is migrated in place, keeping its ID
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceMigrateRegion(instanceName, publicKeyMaterial, "us-central"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					func(*terraform.State) error {
+						if instance.ID != instanceID {
+							return fmt.Errorf("Expected Linode Instance %d to be migrated, but it was replaced by %d", instanceID, instance.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resName, "region", "us-central"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttrSet(resName, "ip_address"),
+					// resources using the addresses are planned with the addresses assigned by the migration
+					resource.TestCheckResourceAttrPair("linode_domain_record.foobar", "target", resName, "ip_address"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLinodeInstance_diskRawResize(t *testing.T) {
 	t.Parallel()
 	var instance linodego.Instance
@@ -667,8 +762,8 @@ func TestAccLinodeInstance_diskRawResize(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "specs.0.disk", "25600"),
 					resource.TestCheckResourceAttr(resName, "config.#", "0"),
 					resource.TestCheckResourceAttr(resName, "disk.#", "1"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "3000"),
-					resource.TestCheckResourceAttr(resName, "disk.0.label", "disk"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "3000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "label"), "disk"),
 					resource.TestCheckResourceAttr(resName, "type", "g6-nanode-1"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					testAccCheckComputeInstanceDisks(&instance, testDisk("disk", testDiskSize(3000))),
@@ -682,8 +777,8 @@ func TestAccLinodeInstance_diskRawResize(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "specs.0.disk", "51200"),
 					resource.TestCheckResourceAttr(resName, "config.#", "0"),
 					resource.TestCheckResourceAttr(resName, "disk.#", "1"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "6000"),
-					resource.TestCheckResourceAttr(resName, "disk.0.label", "disk"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "6000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "label"), "disk"),
 					resource.TestCheckResourceAttr(resName, "type", "g6-standard-1"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					testAccCheckComputeInstanceDisks(&instance, testDisk("disk", testDiskSize(6000))),
@@ -712,8 +807,8 @@ func TestAccLinodeInstance_diskRawDeleted(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "specs.0.disk", "25600"),
 					resource.TestCheckResourceAttr(resName, "config.#", "0"),
 					resource.TestCheckResourceAttr(resName, "disk.#", "1"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "3000"),
-					resource.TestCheckResourceAttr(resName, "disk.0.label", "disk"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "3000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "label"), "disk"),
 					resource.TestCheckResourceAttr(resName, "type", "g6-nanode-1"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					testAccCheckComputeInstanceDisks(&instance, testDisk("disk", testDiskSize(3000))),
@@ -758,7 +853,7 @@ func TestAccLinodeInstance_diskResize(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "specs.0.disk", "25600"),
 					resource.TestCheckResourceAttr(resName, "type", "g6-nanode-1"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "3000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "3000"),
 					testAccCheckComputeInstanceConfigs(&instance, testConfig("config", testConfigKernel("linode/latest-64bit"))),
 					testAccCheckComputeInstanceDisks(&instance, testDisk("disk", testDiskSize(3000))),
 				),
@@ -772,7 +867,7 @@ func TestAccLinodeInstance_diskResize(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "type", "g6-standard-1"),
 
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "6000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "6000"),
 
 					testAccCheckComputeInstanceConfigs(&instance, testConfig("config", testConfigKernel("linode/latest-64bit"))),
 					testAccCheckComputeInstanceDisks(&instance, testDisk("disk", testDiskSize(6000))),
@@ -809,8 +904,9 @@ func TestAccLinodeInstance_diskSlotReorder(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "type", "g6-nanode-1"),
 					testAccCheckComputeInstanceDisks(&instance, testDisk("disk", testDiskExists(&instanceDisk), testDiskSize(3000))),
 					testAccCheckComputeInstanceConfigs(&instance, testConfig("config", testConfigKernel("linode/latest-64bit"), testConfigSDADisk(&instanceDisk))),
-					resource.TestCheckResourceAttrSet(resName, "config.0.devices.0.sda.0.disk_id"),
-					resource.TestCheckResourceAttr(resName, "config.0.devices.0.sdb.#", "0"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "device.#"), "1"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "device.0.slot"), "sda"),
+					resource.TestCheckResourceAttrSet(resName, testInstanceConfigAttr("config", "device.0.disk_id")),
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					testAccCheckComputeInstanceConfigs(&instance, testConfig("config", testConfigKernel("linode/latest-64bit"))),
 				),
@@ -822,19 +918,19 @@ func TestAccLinodeInstance_diskSlotReorder(t *testing.T) {
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					resource.TestCheckResourceAttr(resName, "specs.0.disk", "51200"),
 					resource.TestCheckResourceAttr(resName, "type", "g6-standard-1"),
-					resource.TestCheckResourceAttr(resName, "disk.0.size", "3000"),
-					resource.TestCheckResourceAttr(resName, "disk.0.label", "disk"),
-					resource.TestCheckResourceAttrSet(resName, "disk.0.id"),
-					resource.TestCheckResourceAttr(resName, "disk.1.size", "3000"),
-					resource.TestCheckResourceAttr(resName, "disk.1.label", "diskb"),
-					resource.TestCheckResourceAttrSet(resName, "disk.1.id"),
-					resource.TestCheckResourceAttr(resName, "config.0.label", "config"),
-					resource.TestCheckResourceAttr(resName, "config.0.kernel", "linode/latest-64bit"),
-					resource.TestCheckResourceAttrSet(resName, "config.0.devices.0.sda.0.disk_id"),
-					resource.TestCheckResourceAttrSet(resName, "config.0.devices.0.sdb.0.disk_id"),
-					resource.TestCheckResourceAttr(resName, "config.0.devices.0.sdc.#", "0"),
-					resource.TestCheckResourceAttrPair(resName, "config.0.devices.0.sda.0.disk_id", resName, "disk.1.id"),
-					resource.TestCheckResourceAttrPair(resName, "config.0.devices.0.sdb.0.disk_id", resName, "disk.0.id"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "3000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "label"), "disk"),
+					resource.TestCheckResourceAttrSet(resName, testInstanceDiskAttr("disk", "id")),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("diskb", "size"), "3000"),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("diskb", "label"), "diskb"),
+					resource.TestCheckResourceAttrSet(resName, testInstanceDiskAttr("diskb", "id")),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "label"), "config"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "kernel"), "linode/latest-64bit"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "device.#"), "2"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "device.0.slot"), "sda"),
+					resource.TestCheckResourceAttr(resName, testInstanceConfigAttr("config", "device.1.slot"), "sdb"),
+					resource.TestCheckResourceAttrPair(resName, testInstanceConfigAttr("config", "device.0.disk_id"), resName, testInstanceDiskAttr("diskb", "id")),
+					resource.TestCheckResourceAttrPair(resName, testInstanceConfigAttr("config", "device.1.disk_id"), resName, testInstanceDiskAttr("disk", "id")),
 
 					resource.TestCheckResourceAttr(resName, "swap_size", "0"),
 					resource.TestCheckResourceAttr(resName, "status", "running"),
@@ -865,15 +961,466 @@ func TestAccLinodeInstance_privateNetworking(t *testing.T) {
 					testAccCheckLinodeInstanceExists(resName, &instance),
 					testAccCheckLinodeInstanceAttributesPrivateNetworking("linode_instance.foobar"),
 					resource.TestCheckResourceAttr(resName, "private_ip", "true"),
+					resource.TestCheckResourceAttr(resName, "ipv4_public.#", "1"),
+					resource.TestCheckResourceAttrPair(resName, "ipv4_public.0.address", resName, "ip_address"),
+					resource.TestCheckResourceAttrSet(resName, "ipv4_public.0.gateway"),
+					resource.TestCheckResourceAttrSet(resName, "ipv4_public.0.rdns"),
+					resource.TestCheckResourceAttr(resName, "ipv4_private.#", "1"),
+					resource.TestCheckResourceAttrPair(resName, "ipv4_private.0.address", resName, "private_ip_address"),
+					resource.TestCheckResourceAttr(resName, "ipv4_private.0.prefix", "17"),
+					resource.TestCheckResourceAttr(resName, "ipv6_slaac.#", "1"),
+					resource.TestCheckResourceAttr(resName, "ipv6_slaac.0.prefix", "64"),
+					resource.TestCheckResourceAttr(resName, "ipv6_link_local.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_booted(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceBooted(instanceName, publicKeyMaterial, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "booted", "false"),
+					resource.TestCheckResourceAttr(resName, "status", "offline"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceBooted(instanceName, publicKeyMaterial, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "booted", "true"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceBooted(instanceName, publicKeyMaterial, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "booted", "false"),
+					resource.TestCheckResourceAttr(resName, "status", "offline"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_rebuild(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance, rebuiltInstance linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceRebuild(instanceName, publicKeyMaterial, "linode/ubuntu18.04"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "image", "linode/ubuntu18.04"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceRebuild(instanceName, publicKeyMaterial, "linode/debian9"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &rebuiltInstance),
+					resource.TestCheckResourceAttr(resName, "image", "linode/debian9"),
+					func(*terraform.State) error {
+						if instance.ID != rebuiltInstance.ID {
+							return fmt.Errorf("Expected Linode Instance %d to be rebuilt in place, found Linode Instance %d", instance.ID, rebuiltInstance.ID)
+						}
+						if rebuiltInstance.Image != "linode/debian9" {
+							return fmt.Errorf("Expected Linode Instance %d to be rebuilt from linode/debian9, found %s", rebuiltInstance.ID, rebuiltInstance.Image)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_cloneFrom(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.clone"
+	var instance, clone linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceCloneFrom(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists("linode_instance.foobar", &instance),
+					testAccCheckLinodeInstanceExists(resName, &clone),
+					resource.TestCheckResourceAttr(resName, "label", instanceName+"_clone"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttr(resName, "disk.#", "2"),
+					resource.TestCheckResourceAttr(resName, "config.#", "1"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_backupSchedule(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceBackupSchedule(instanceName, "Sunday", "W2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "backups.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.day", "Sunday"),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.window", "W2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceBackupSchedule(instanceName, "Saturday", "W22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.day", "Saturday"),
+					resource.TestCheckResourceAttr(resName, "backups.0.schedule.0.window", "W22"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_rebootPolicy(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Error generating test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceRebootPolicy(instanceName, publicKeyMaterial, 3000, "never"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "reboot_policy", "never"),
+					resource.TestCheckResourceAttr(resName, "pending_reboot", "false"),
+				),
+			},
+			// Resizing the disk requires a reboot that the policy defers
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceRebootPolicy(instanceName, publicKeyMaterial, 4000, "never"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, testInstanceDiskAttr("disk", "size"), "4000"),
+					resource.TestCheckResourceAttr(resName, "pending_reboot", "true"),
+				),
+			},
+			// Switching back to auto catches up on the pending reboot
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceRebootPolicy(instanceName, publicKeyMaterial, 4000, "auto"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttr(resName, "pending_reboot", "false"),
+					resource.TestCheckResourceAttr(resName, "planned_reboot_reasons.#", "0"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccLinodeInstance_privateIPNoReboot(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceBasic(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "private_ip", "false"),
+				),
+			},
+			// An instance deployed from an image is not rebooted, private networking is configured on its next boot
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigPrivateNetworking(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "private_ip", "true"),
+					resource.TestCheckResourceAttrSet(resName, "private_ip_address"),
+					resource.TestCheckResourceAttr(resName, "pending_reboot", "false"),
+					resource.TestCheckResourceAttr(resName, "planned_reboot_reasons.#", "0"),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					testAccCheckLinodeInstanceNoEvent(&instance, linodego.ActionLinodeReboot),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_shutdownBeforeDestroy(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instance linodego.Instance
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Error generating test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckLinodeInstanceDestroy,
+			testAccCheckLinodeInstanceEvent(&instance, linodego.ActionLinodeShutdown),
+		),
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceShutdownBeforeDestroy(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists(resName, &instance),
+					resource.TestCheckResourceAttr(resName, "status", "running"),
+					resource.TestCheckResourceAttr(resName, "shutdown_before_destroy", "true"),
+					resource.TestCheckResourceAttr(resName, "shutdown_timeout", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstance_multipleConfigsRequireBootConfigLabel(t *testing.T) {
+	t.Parallel()
+
+	var instanceName = acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckLinodeInstanceWithMultipleConfigsNoBootConfig(instanceName),
+				ExpectError: regexp.MustCompile("boot_config_label must be set when there is more than one config"),
+			},
+		},
+	})
+}
+
+func TestLinodeInstanceRebootAllowed(t *testing.T) {
+	onChangeOf := schema.NewSet(schema.HashString, []interface{}{"config"})
+
+	cases := []struct {
+		Policy   string
+		Reasons  []string
+		Expected bool
+	}{
+		{"auto", []string{"disk"}, true},
+		{"never", []string{"disk", "config"}, false},
+		{"on_change_of", []string{"disk"}, false},
+		{"on_change_of", []string{"disk", "config"}, true},
+	}
+
+	for _, tc := range cases {
+		if allowed := instanceRebootAllowed(tc.Policy, onChangeOf, tc.Reasons); allowed != tc.Expected {
+			t.Fatalf("bad: %s %v, expected %t, got %t", tc.Policy, tc.Reasons, tc.Expected, allowed)
+		}
+	}
+}
+
+func TestLinodeInstanceConfigDeviceOrder(t *testing.T) {
+	cases := []struct {
+		Slots []string
+		Valid bool
+	}{
+		{[]string{}, true},
+		{[]string{"sda", "sdb"}, true},
+		{[]string{"sda", "sdc"}, true},
+		{[]string{"sdb", "sda"}, false},
+		{[]string{"sda", "sda"}, false},
+	}
+
+	for _, tc := range cases {
+		devices := make([]interface{}, len(tc.Slots))
+		for i, slot := range tc.Slots {
+			devices[i] = map[string]interface{}{"slot": slot}
+		}
+		if err := validateInstanceConfigDeviceOrder(devices); (err == nil) != tc.Valid {
+			t.Fatalf("bad: %v, expected valid %t, got %v", tc.Slots, tc.Valid, err)
+		}
+	}
+}
+
+func TestLinodeInstanceSetIPAddresses(t *testing.T) {
+	d := resourceLinodeInstance().TestResourceData()
+
+	network := &linodego.InstanceIPAddressResponse{
+		IPv4: &linodego.InstanceIPv4Response{
+			Public: []*linodego.InstanceIP{
+				{Address: "203.0.113.10", Gateway: "203.0.113.1", Prefix: 24, Public: true, RDNS: "li10.example.com"},
+				{Address: "203.0.113.20", Gateway: "203.0.113.1", Prefix: 24, Public: true},
+			},
+			Private: []*linodego.InstanceIP{{Address: "192.168.128.10", Prefix: 17}},
+		},
+		IPv6: &linodego.InstanceIPv6Response{
+			SLAAC:  &linodego.InstanceIP{Address: "2600:3c03::f03c:91ff:fe24:3a2f", Prefix: 64, Public: true},
+			Global: []*linodego.IPv6Range{{Range: "2600:3c03:e000:100::/64", Region: "us-east"}},
+		},
+	}
+
+	if err := setInstanceIPAddresses(d, network); err != nil {
+		t.Fatalf("Error setting IP addresses: %s", err)
+	}
+
+	cases := map[string]interface{}{
+		"ipv4_public.#":         2,
+		"ipv4_public.0.address": "203.0.113.10",
+		"ipv4_public.0.rdns":    "li10.example.com",
+		"ipv4_public.1.address": "203.0.113.20",
+		"ipv4_private.0.prefix": 17,
+		"ipv4_shared.#":         0,
+		"ipv4_reserved.#":       0,
+		"ipv6_slaac.0.address":  "2600:3c03::f03c:91ff:fe24:3a2f",
+		"ipv6_link_local.#":     0,
+		"ipv6_ranges.0.range":   "2600:3c03:e000:100::",
+		"ipv6_ranges.0.prefix":  64,
+	}
+
+	for key, expected := range cases {
+		if actual := d.Get(key); actual != expected {
+			t.Errorf("bad: %s, expected %v, got %v", key, expected, actual)
+		}
+	}
+}
+
+// testAccCheckLinodeInstanceEvent checks that the account events include the action on the Linode Instance
+func testAccCheckLinodeInstanceEvent(instance *linodego.Instance, action linodego.EventAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ProviderMeta).Client
+
+		found, err := findLinodeInstanceEvent(client, instance.ID, action)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("Expected a %s event for Linode Instance %d", action, instance.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckLinodeInstanceNoEvent(instance *linodego.Instance, action linodego.EventAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ProviderMeta).Client
+
+		found, err := findLinodeInstanceEvent(client, instance.ID, action)
+		if err != nil {
+			return err
+		}
+		if found {
+			return fmt.Errorf("Expected no %s event for Linode Instance %d", action, instance.ID)
+		}
+		return nil
+	}
+}
+
+// findLinodeInstanceEvent reports whether the account events include the action on the Linode Instance
+func findLinodeInstanceEvent(client linodego.Client, linodeID int, action linodego.EventAction) (bool, error) {
+	filter := fmt.Sprintf(`{"entity.type": "linode", "entity.id": %d, "action": "%s"}`, linodeID, action)
+	events, err := client.ListEvents(context.Background(), linodego.NewListOptions(1, filter))
+	if err != nil {
+		return false, fmt.Errorf("Error listing the events of Linode Instance %d: %s", linodeID, err)
+	}
+	return len(events) > 0, nil
+}
+
+func testAccCheckLinodeInstanceTotalDiskSize(instance *linodego.Instance, size int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ProviderMeta).Client
+
+		totalDiskSize, err := getTotalDiskSize(&client, instance.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the disks of Linode Instance %d: %s", instance.ID, err)
+		}
+		if totalDiskSize != size {
+			return fmt.Errorf("Expected Linode Instance %d disks to total %d MB, got %d MB", instance.ID, size, totalDiskSize)
+		}
+		return nil
+	}
+}
+
+// testInstanceDiskAttr returns the state attribute key of a linode_instance disk identified by its label
+func testInstanceDiskAttr(label string, attr string) string {
+	return fmt.Sprintf("disk.%d.%s", labelHashcode(label), attr)
+}
+
+// testInstanceConfigAttr returns the state attribute key of a linode_instance config identified by its label
+func testInstanceConfigAttr(label string, attr string) string {
+	return fmt.Sprintf("config.%d.%s", configHashcode(label), attr)
+}
+
 func testAccCheckLinodeInstanceExists(name string, instance *linodego.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(linodego.Client)
+		client := testAccProvider.Meta().(*ProviderMeta).Client
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -901,10 +1448,11 @@ func testAccCheckLinodeInstanceExists(name string, instance *linodego.Instance)
 }
 
 func testAccCheckLinodeInstanceDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_instance" {
 			continue
@@ -949,10 +1497,11 @@ func testAccCheckLinodeInstanceAttributesPrivateNetworking(n string) resource.Te
 			return fmt.Errorf("should have an integer Linode ID: %s", err)
 		}
 
-		client, ok := testAccProvider.Meta().(linodego.Client)
+		providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 		if !ok {
 			return fmt.Errorf("should have a linodego.Client")
 		}
+		client := providerMeta.Client
 
 		if err != nil {
 			return err
@@ -1006,7 +1555,7 @@ func testDiskSize(size int) testDiskFunc {
 
 func testAccCheckComputeInstanceDisks(instance *linodego.Instance, disksTests ...testDisksFunc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(linodego.Client)
+		client := testAccProvider.Meta().(*ProviderMeta).Client
 
 		if instance == nil || instance.ID == 0 {
 			return fmt.Errorf("Error fetching disks: invalid Instance argument")
@@ -1120,7 +1669,7 @@ func instanceDiskID(disk *linodego.InstanceDisk) string {
 // testAccCheckComputeInstanceConfigs verifies any configs exist and runs config specific tests against a target instance
 func testAccCheckComputeInstanceConfigs(instance *linodego.Instance, configsTests ...testConfigsFunc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(linodego.Client)
+		client := testAccProvider.Meta().(*ProviderMeta).Client
 
 		if instance == nil || instance.ID == 0 {
 			return fmt.Errorf("Error fetching configs: invalid Instance argument")
@@ -1148,7 +1697,7 @@ func testAccCheckComputeInstanceConfigs(instance *linodego.Instance, configsTest
 
 func testAccCheckLinodeInstanceDiskExists(instance *linodego.Instance, label string, instanceDisk *linodego.InstanceDisk) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(linodego.Client)
+		client := testAccProvider.Meta().(*ProviderMeta).Client
 
 		if instance == nil || instance.ID == 0 {
 			return fmt.Errorf("Error fetching disks: invalid Instance argument")
@@ -1177,7 +1726,7 @@ func testAccCheckLinodeInstanceDiskExists(instance *linodego.Instance, label str
 
 func testAccCheckComputeInstanceDisk(instance *linodego.Instance, label string, size int) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(linodego.Client)
+		client := testAccProvider.Meta().(*ProviderMeta).Client
 
 		if instance == nil || instance.ID == 0 {
 			return fmt.Errorf("Error fetching disks: invalid Instance argument")
@@ -1214,9 +1763,71 @@ resource "linode_instance" "foobar" {
 	root_pass = "terraform-test"
 	swap_size = 256
 	authorized_keys = ["%s"]
+	tags = ["tf_test"]
 }`, instance, pubkey)
 }
 
+func testAccCheckLinodeInstanceBooted(instance string, pubkey string, booted bool) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	authorized_keys = ["%s"]
+	booted = %t
+}`, instance, pubkey, booted)
+}
+
+func testAccCheckLinodeInstanceRebuild(instance string, pubkey string, image string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "%s"
+	region = "us-east"
+	root_pass = "terraform-test"
+	authorized_keys = ["%s"]
+	rebuild_on_change = true
+}`, instance, image, pubkey)
+}
+
+func testAccCheckLinodeInstanceCloneFrom(instance string, pubkey string) string {
+	return testAccCheckLinodeInstanceBasic(instance, pubkey) + fmt.Sprintf(`
+resource "linode_instance" "clone" {
+	label = "%s_clone"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	tags = ["tf_test"]
+	clone_from {
+		linode_id = "${linode_instance.foobar.id}"
+	}
+}`, instance)
+}
+
+func testAccCheckLinodeInstanceBackupSchedule(instance string, day string, window string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	backups_enabled = true
+	backups {
+		schedule {
+			day = "%s"
+			window = "%s"
+		}
+	}
+}`, instance, day, window)
+}
+
 func testAccCheckLinodeInstanceWithConfig(instance string, pubkey string) string {
 	return fmt.Sprintf(`
 resource "linode_instance" "foobar" {
@@ -1262,6 +1873,26 @@ resource "linode_instance" "foobar" {
 }`, instance)
 }
 
+func testAccCheckLinodeInstanceWithMultipleConfigsNoBootConfig(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	config {
+		label = "configa"
+		kernel = "linode/latest-64bit"
+		root_device = "/dev/root"
+	}
+	config {
+		label = "configb"
+		kernel = "linode/latest-32bit"
+		root_device = "/dev/root"
+	}
+}`, instance)
+}
+
 func testAccCheckLinodeInstanceWithMultipleConfigsReverseOrder(instance string, pubkey string) string {
 	return fmt.Sprintf(`
 resource "linode_instance" "foobar" {
@@ -1410,7 +2041,10 @@ resource "linode_instance" "foobar" {
 	config {
 		label = "config"
 		kernel = "linode/latest-64bit"
-		devices = { sda = { disk_label = "disk" } }
+		device {
+			slot = "sda"
+			disk_label = "disk"
+		}
 	}
 }`, instance, pubkey)
 }
@@ -1434,7 +2068,10 @@ resource "linode_instance" "foobar" {
 	config {
 		label = "config"
 		kernel = "linode/latest-64bit"
-		devices = { sda = { disk_label = "disk" } }
+		device {
+			slot = "sda"
+			disk_label = "disk"
+		}
 	}
 }`, instance, pubkey)
 }
@@ -1466,9 +2103,13 @@ resource "linode_instance" "foobar" {
 	config {
 		label = "config"
 		kernel = "linode/latest-64bit"
-		devices = {
-			sda = { disk_label = "diskb" }, 
-			sdb = { disk_label = "disk" }
+		device {
+			slot = "sda"
+			disk_label = "diskb"
+		}
+		device {
+			slot = "sdb"
+			disk_label = "disk"
 		}
 	}
 }`, instance, pubkey, pubkey)
@@ -1499,14 +2140,28 @@ resource "linode_instance" "foobar" {
 	config {
 		label = "configa"
 		kernel = "linode/latest-64bit"
-		devices = { sda = { disk_label = "diska" }, sdb = { disk_label = "diskb" } }
+		device {
+			slot = "sda"
+			disk_label = "diska"
+		}
+		device {
+			slot = "sdb"
+			disk_label = "diskb"
+		}
 	}
 
 	config {
 		label = "configb"
 		comments = "won't boot"
 		kernel = "linode/grub2"
-		devices = { sda = { disk_label = "diskb" }, sdb = { disk_label = "diska" } }
+		device {
+			slot = "sda"
+			disk_label = "diskb"
+		}
+		device {
+			slot = "sdb"
+			disk_label = "diska"
+		}
 	}
 
 	boot_config_label = "configa"
@@ -1538,9 +2193,13 @@ resource "linode_instance" "foobar" {
 	config {
 		label = "config"
 		kernel = "linode/latest-64bit"
-		devices = {
-			sda = { disk_label = "disk" },
-			sdb = { volume_id = "${linode_volume.foo.id}" }
+		device {
+			slot = "sda"
+			disk_label = "disk"
+		}
+		device {
+			slot = "sdb"
+			volume_id = "${linode_volume.foo.id}"
 		}
 	}
 }`, instance, instance, pubkey)
@@ -1554,6 +2213,7 @@ resource "linode_instance" "foobar" {
 	type = "g6-nanode-1"
 	region = "us-east"
 	group = "tf_test_r"
+	tags = ["tf_test", "tf_test_r"]
 
 	config {
 		label = "config"
@@ -1635,13 +2295,13 @@ resource "linode_instance" "foobar" {
 func testAccCheckLinodeInstanceConfigUpsizeExpandDisk(instance string, pubkey string) string {
 	return fmt.Sprintf(`
 resource "linode_instance" "foobar" {
-	label = "%s_expanded"
+	label = "%s"
 	type = "g6-standard-1"
-	disk_expansion = true
+	resize_disk = true
 	image = "linode/ubuntu18.04"
 	region = "us-east"
 	root_pass = "terraform-test"
-	swap_size = 256
+	swap_size = 512
 	authorized_keys = ["%s"]
 	group = "tf_test"
 }`, instance, pubkey)
@@ -1661,3 +2321,70 @@ resource "linode_instance" "foobar" {
 	group = "tf_test"
 }`, instance, pubkey)
 }
+
+func testAccCheckLinodeInstanceRebootPolicy(instance string, pubkey string, size int, policy string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	region = "us-east"
+	group = "tf_test"
+	reboot_policy = "%s"
+
+	disk {
+		label = "disk"
+		image = "linode/ubuntu18.04"
+		root_pass = "b4d_p4s5"
+		authorized_keys = ["%s"]
+		size = %d
+	}
+
+	config {
+		label = "config"
+		kernel = "linode/latest-64bit"
+		device {
+			slot = "sda"
+			disk_label = "disk"
+		}
+	}
+}`, instance, policy, pubkey, size)
+}
+
+func testAccCheckLinodeInstanceShutdownBeforeDestroy(instance string, pubkey string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_pass = "terraform-test"
+	authorized_keys = ["%s"]
+	shutdown_before_destroy = true
+	shutdown_timeout = 120
+}`, instance, pubkey)
+}
+
+func testAccCheckLinodeInstanceMigrateRegion(instance string, pubkey string, region string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "%s"
+	root_pass = "terraform-test"
+	authorized_keys = ["%s"]
+	migrate_on_region_change = true
+
+	timeouts {
+		update = "60m"
+	}
+}`, instance, region, pubkey) + testAccCheckLinodeDomainConfigBasic(strings.Replace(instance, "_", "-", -1)+".example") + `
+resource "linode_domain_record" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	name = "www"
+	record_type = "A"
+	target = "${linode_instance.foobar.ip_address}"
+}`
+}
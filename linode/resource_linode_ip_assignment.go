@@ -0,0 +1,179 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeIPAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeIPAssignmentCreate,
+		Read:   resourceLinodeIPAssignmentRead,
+		Update: resourceLinodeIPAssignmentUpdate,
+		Delete: resourceLinodeIPAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLinodeIPAssignmentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The region of the Linode Instances and IPv4 addresses.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"assignment": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The IPv4 addresses to assign and the Linode Instances to assign them to.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Description:  "The IPv4 address to assign.",
+							Required:     true,
+							ValidateFunc: validation.SingleIP(),
+						},
+						"linode_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the Linode Instance to assign the address to.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLinodeIPAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	var assignments []map[string]interface{}
+	for _, rassignment := range d.Get("assignment").(*schema.Set).List() {
+		address := rassignment.(map[string]interface{})["address"].(string)
+
+		ip, err := client.GetIPAddress(context.Background(), address)
+		if err != nil {
+			if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+				log.Printf("[WARN] removing Linode IP address %s from IP Assignment %q because it no longer exists", address, d.Id())
+				continue
+			}
+			return fmt.Errorf("Error finding the specified Linode IP address %s: %s", address, err)
+		}
+
+		assignments = append(assignments, map[string]interface{}{
+			"address":   ip.Address,
+			"linode_id": ip.LinodeID,
+		})
+	}
+
+	if len(assignments) == 0 {
+		log.Printf("[WARN] removing Linode IP Assignment %q from state because none of its addresses exist", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("assignment", assignments)
+
+	return nil
+}
+
+func resourceLinodeIPAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := strings.Split(d.Id(), ",")
+	if len(s) < 2 {
+		return nil, fmt.Errorf("invalid IP assignment ID %q, expected the region followed by the addresses, separated by commas", d.Id())
+	}
+
+	assignments := make([]map[string]interface{}, 0, len(s)-1)
+	for _, address := range s[1:] {
+		assignments = append(assignments, map[string]interface{}{"address": address})
+	}
+	d.Set("region", s[0])
+	d.Set("assignment", assignments)
+
+	err := resourceLinodeIPAssignmentRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import %v as ip_assignment: %v", d.Id(), err)
+	}
+
+	results := make([]*schema.ResourceData, 0)
+	results = append(results, d)
+
+	return results, nil
+}
+
+func resourceLinodeIPAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode IP Assignment")
+	}
+	client := providerMeta.Client
+
+	region := d.Get("region").(string)
+	assignments := d.Get("assignment").(*schema.Set)
+	if err := assignIPAddresses(client, region, assignments); err != nil {
+		return err
+	}
+	d.SetId(ipAssignmentID(region, assignments))
+
+	return resourceLinodeIPAssignmentRead(d, meta)
+}
+
+func resourceLinodeIPAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	if d.HasChange("assignment") {
+		region := d.Get("region").(string)
+		assignments := d.Get("assignment").(*schema.Set)
+		if err := assignIPAddresses(client, region, assignments); err != nil {
+			return err
+		}
+		d.SetId(ipAssignmentID(region, assignments))
+	}
+
+	return resourceLinodeIPAssignmentRead(d, meta)
+}
+
+func resourceLinodeIPAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	// An assignment can not be undone, destroying it leaves the addresses where they are
+	d.SetId("")
+	return nil
+}
+
+// ipAssignmentID identifies an IP Assignment by its region and sorted addresses, as an address can only be assigned to
+// one Linode Instance at a time
+func ipAssignmentID(region string, assignments *schema.Set) string {
+	addresses := make([]string, 0, assignments.Len())
+	for _, rassignment := range assignments.List() {
+		addresses = append(addresses, rassignment.(map[string]interface{})["address"].(string))
+	}
+	sort.Strings(addresses)
+	return strings.Join(append([]string{region}, addresses...), ",")
+}
+
+// assignIPAddresses moves IPv4 addresses between Linode Instances of a region in a single request
+func assignIPAddresses(client linodego.Client, region string, assignments *schema.Set) error {
+	assignOpts := linodego.LinodesAssignIPsOptions{
+		Region:      region,
+		Assignments: make([]linodego.LinodeIPAssignment, 0, assignments.Len()),
+	}
+	for _, rassignment := range assignments.List() {
+		assignment := rassignment.(map[string]interface{})
+		assignOpts.Assignments = append(assignOpts.Assignments, linodego.LinodeIPAssignment{
+			Address:  assignment["address"].(string),
+			LinodeID: assignment["linode_id"].(int),
+		})
+	}
+
+	if err := client.AssignIPAddresses(context.Background(), assignOpts); err != nil {
+		return fmt.Errorf("Error assigning IPs in region %s: %s", region, err)
+	}
+	return nil
+}
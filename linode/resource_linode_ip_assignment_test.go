@@ -0,0 +1,131 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeIPAssignment_swap(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_ip_assignment.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	// The addresses are only known once the Linode Instances exist. They are given to the
+	// assignment as literals, so that they don't follow the ip_address of the Linode Instances.
+	var primaryID, standbyID int
+	var primaryAddress, standbyAddress string
+
+	steps := []resource.TestStep{
+		resource.TestStep{
+			Config: testAccCheckLinodeIPAssignmentConfigInstances(instanceName),
+		},
+		// The addresses of the two Linode Instances are swapped atomically
+		resource.TestStep{
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr(resName, "region", "us-east"),
+				resource.TestCheckResourceAttr(resName, "assignment.#", "2"),
+				testAccCheckLinodeIPAssignmentInstanceAddress("linode_instance.primary", &standbyAddress),
+				testAccCheckLinodeIPAssignmentInstanceAddress("linode_instance.standby", &primaryAddress),
+			),
+		},
+		resource.TestStep{
+			ResourceName:      resName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		},
+		// Moving the addresses back outside of Terraform is detected as drift
+		resource.TestStep{
+			PreConfig: func() {
+				client := testAccProvider.Meta().(*ProviderMeta).Client
+				assignOpts := linodego.LinodesAssignIPsOptions{
+					Region: "us-east",
+					Assignments: []linodego.LinodeIPAssignment{
+						{Address: primaryAddress, LinodeID: primaryID},
+						{Address: standbyAddress, LinodeID: standbyID},
+					},
+				}
+				if err := client.AssignIPAddresses(context.Background(), assignOpts); err != nil {
+					t.Fatalf("Error moving the addresses back: %s", err)
+				}
+			},
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	}
+
+	steps[0].Check = func(s *terraform.State) error {
+		var err error
+		primary := s.RootModule().Resources["linode_instance.primary"].Primary
+		standby := s.RootModule().Resources["linode_instance.standby"].Primary
+		if primaryID, err = strconv.Atoi(primary.ID); err != nil {
+			return fmt.Errorf("Error parsing Linode Instance ID %s as int: %s", primary.ID, err)
+		}
+		if standbyID, err = strconv.Atoi(standby.ID); err != nil {
+			return fmt.Errorf("Error parsing Linode Instance ID %s as int: %s", standby.ID, err)
+		}
+		primaryAddress, standbyAddress = primary.Attributes["ip_address"], standby.Attributes["ip_address"]
+
+		config := testAccCheckLinodeIPAssignmentConfigSwap(instanceName, primaryAddress, standbyAddress)
+		steps[1].Config = config
+		steps[3].Config = config
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps:        steps,
+	})
+}
+
+func testAccCheckLinodeIPAssignmentInstanceAddress(name string, address *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckResourceAttr(name, "ip_address", *address)(s)
+	}
+}
+
+func testAccCheckLinodeIPAssignmentConfigInstances(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "primary" {
+	label = "%s_primary"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_instance" "standby" {
+	label = "%s_standby"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}`, instance, instance)
+}
+
+func testAccCheckLinodeIPAssignmentConfigSwap(instance string, primaryAddress string, standbyAddress string) string {
+	return testAccCheckLinodeIPAssignmentConfigInstances(instance) + fmt.Sprintf(`
+
+resource "linode_ip_assignment" "foobar" {
+	region = "us-east"
+
+	assignment {
+		address = "%s"
+		linode_id = "${linode_instance.standby.id}"
+	}
+
+	assignment {
+		address = "%s"
+		linode_id = "${linode_instance.primary.id}"
+	}
+}`, primaryAddress, standbyAddress)
+}
@@ -0,0 +1,130 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeIPv6Range() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeIPv6RangeCreate,
+		Read:   resourceLinodeIPv6RangeRead,
+		Delete: resourceLinodeIPv6RangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode Instance to route the IPv6 range to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"prefix_length": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "The prefix length of the IPv6 range, 56 or 64.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPv6RangePrefixLength,
+			},
+			"range": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The first address of the IPv6 range.",
+				Computed:    true,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Region of the IPv6 range.",
+				Computed:    true,
+			},
+			"route_target": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The address of the Linode Instance the IPv6 range is routed to.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeIPv6RangeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	ipv6Range, err := client.GetIPv6Range(context.Background(), d.Id())
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode IPv6 Range %q from state because it no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode IPv6 Range: %s", err)
+	}
+
+	address, prefix := splitIPv6Range(*ipv6Range)
+	d.Set("range", address)
+	if prefix != 0 {
+		d.Set("prefix_length", prefix)
+	}
+	d.Set("region", ipv6Range.Region)
+	if ipv6Range.RouteTarget != "" {
+		d.Set("route_target", ipv6Range.RouteTarget)
+
+		// the range is routed to the SLAAC address of a Linode Instance, so re-routing it is detected through its route target
+		routeTarget, err := client.GetIPAddress(context.Background(), ipv6Range.RouteTarget)
+		if err != nil {
+			return fmt.Errorf("Error finding the route target %s of Linode IPv6 Range %s: %s", ipv6Range.RouteTarget, d.Id(), err)
+		}
+		d.Set("linode_id", routeTarget.LinodeID)
+	}
+
+	return nil
+}
+
+func resourceLinodeIPv6RangeCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode IPv6 Range")
+	}
+	client := providerMeta.Client
+
+	linodeID := d.Get("linode_id").(int)
+	createOpts := linodego.IPv6RangeCreateOptions{
+		LinodeID:     linodeID,
+		PrefixLength: d.Get("prefix_length").(int),
+	}
+
+	ipv6Range, err := client.CreateIPv6Range(context.Background(), createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating a Linode IPv6 Range for Linode Instance %d: %s", linodeID, err)
+	}
+
+	address, _ := splitIPv6Range(*ipv6Range)
+	d.SetId(address)
+	d.Set("route_target", ipv6Range.RouteTarget)
+
+	return resourceLinodeIPv6RangeRead(d, meta)
+}
+
+func resourceLinodeIPv6RangeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	if err := client.DeleteIPv6Range(context.Background(), d.Id()); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Error deleting Linode IPv6 Range %s: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// validateIPv6RangePrefixLength accepts the prefix lengths of the IPv6 ranges Linode can route to an Instance
+func validateIPv6RangePrefixLength(v interface{}, k string) (ws []string, errors []error) {
+	if prefixLength := v.(int); prefixLength != 56 && prefixLength != 64 {
+		errors = append(errors, fmt.Errorf("%q must be 56 or 64, got %d", k, prefixLength))
+	}
+	return
+}
@@ -0,0 +1,132 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeIPv6Range_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_ipv6_range.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeIPv6RangeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeIPv6RangeConfigBasic(instanceName, 64),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeIPv6RangeExists,
+					resource.TestCheckResourceAttrPair(resName, "linode_id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resName, "range"),
+					resource.TestCheckResourceAttrSet(resName, "route_target"),
+					resource.TestCheckResourceAttr(resName, "prefix_length", "64"),
+					resource.TestCheckResourceAttr(resName, "region", "us-east"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestLinodeIPv6RangeValidatePrefixLength(t *testing.T) {
+	for _, prefixLength := range []int{56, 64} {
+		if _, errs := validateIPv6RangePrefixLength(prefixLength, "prefix_length"); len(errs) != 0 {
+			t.Errorf("expected prefix length %d to be valid, got %v", prefixLength, errs)
+		}
+	}
+	for _, prefixLength := range []int{0, 48, 116, 128} {
+		if _, errs := validateIPv6RangePrefixLength(prefixLength, "prefix_length"); len(errs) == 0 {
+			t.Errorf("expected prefix length %d to be invalid", prefixLength)
+		}
+	}
+}
+
+func TestLinodeIPv6RangeSplit(t *testing.T) {
+	cases := []struct {
+		ipv6Range linodego.IPv6Range
+		address   string
+		prefix    int
+	}{
+		{linodego.IPv6Range{Range: "2600:3c01::/64"}, "2600:3c01::", 64},
+		{linodego.IPv6Range{Range: "2600:3c01::", Prefix: 56}, "2600:3c01::", 56},
+		{linodego.IPv6Range{Range: "2600:3c01::/116", Prefix: 116}, "2600:3c01::", 116},
+	}
+
+	for _, c := range cases {
+		if address, prefix := splitIPv6Range(c.ipv6Range); address != c.address || prefix != c.prefix {
+			t.Errorf("expected %s to split into %s and %d, got %s and %d", c.ipv6Range.Range, c.address, c.prefix, address, prefix)
+		}
+	}
+}
+
+func testAccCheckLinodeIPv6RangeExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_ipv6_range" {
+			continue
+		}
+
+		_, err := client.GetIPv6Range(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of IPv6 Range %s: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeIPv6RangeDestroy(s *terraform.State) error {
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Error getting Linode client")
+	}
+	client := providerMeta.Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_ipv6_range" {
+			continue
+		}
+
+		_, err := client.GetIPv6Range(context.Background(), rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Linode IPv6 Range %s still exists", rs.Primary.ID)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Error requesting Linode IPv6 Range %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeIPv6RangeConfigBasic(instance string, prefixLength int) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_ipv6_range" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	prefix_length = %d
+}`, instance, prefixLength)
+}
@@ -19,8 +19,11 @@ func resourceLinodeNodeBalancer() *schema.Resource {
 		Update: resourceLinodeNodeBalancerUpdate,
 		Delete: resourceLinodeNodeBalancerDelete,
 		Exists: resourceLinodeNodeBalancerExists,
+
+		CustomizeDiff: customizeDiffTags,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateWithTags(resourceLinodeNodeBalancerRead),
 		},
 		Schema: map[string]*schema.Schema{
 			"label": &schema.Schema{
@@ -42,6 +45,8 @@ func resourceLinodeNodeBalancer() *schema.Resource {
 				Optional:     true,
 				Default:      0,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"hostname": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "This NodeBalancer's hostname, ending with .nodebalancer.linode.com",
@@ -93,7 +98,7 @@ func resourceLinodeNodeBalancer() *schema.Resource {
 }
 
 func resourceLinodeNodeBalancerExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return false, fmt.Errorf("Error parsing Linode NodeBalancer ID %s as int: %s", d.Id(), err)
@@ -120,7 +125,7 @@ func floatString(f *float64) string {
 }
 
 func resourceLinodeNodeBalancerRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode NodeBalancer ID %s as int: %s", d.Id(), err)
@@ -146,6 +151,8 @@ func resourceLinodeNodeBalancerRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("client_conn_throttle", nodebalancer.ClientConnThrottle)
 	d.Set("created", nodebalancer.Created.Format(time.RFC3339))
 	d.Set("updated", nodebalancer.Updated.Format(time.RFC3339))
+	d.Set("tags", flattenTags(d, meta, nodebalancer.Tags))
+	d.Set("tags_all", flattenTagsAll(d, meta, nodebalancer.Tags))
 	transfer := map[string]interface{}{
 		"in":    floatString(nodebalancer.Transfer.In),
 		"out":   floatString(nodebalancer.Transfer.Out),
@@ -160,10 +167,11 @@ func resourceLinodeNodeBalancerRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceLinodeNodeBalancerCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode NodeBalancer")
 	}
+	client := providerMeta.Client
 	label := d.Get("label").(string)
 	clientConnThrottle := d.Get("client_conn_throttle").(int)
 
@@ -171,6 +179,7 @@ func resourceLinodeNodeBalancerCreate(d *schema.ResourceData, meta interface{})
 		Region:             d.Get("region").(string),
 		Label:              &label,
 		ClientConnThrottle: &clientConnThrottle,
+		Tags:               expandTags(d, meta, nil),
 	}
 	nodebalancer, err := client.CreateNodeBalancer(context.Background(), createOpts)
 	if err != nil {
@@ -182,7 +191,7 @@ func resourceLinodeNodeBalancerCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLinodeNodeBalancerUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -194,13 +203,15 @@ func resourceLinodeNodeBalancerUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error fetching data about the current NodeBalancer: %s", err)
 	}
 
-	if d.HasChange("label") || d.HasChange("client_conn_throttle") {
+	if d.HasChange("label") || d.HasChange("client_conn_throttle") || hasTagsChange(d) {
 		label := d.Get("label").(string)
 		clientConnThrottle := d.Get("client_conn_throttle").(int)
+		tags := expandTags(d, meta, nodebalancer.Tags)
 		// @TODO nodebalancer.GetUpdateOptions, avoid clobbering client_conn_throttle
 		updateOpts := linodego.NodeBalancerUpdateOptions{
 			Label:              &label,
 			ClientConnThrottle: &clientConnThrottle,
+			Tags:               &tags,
 		}
 		if nodebalancer, err = client.UpdateNodeBalancer(context.Background(), nodebalancer.ID, updateOpts); err != nil {
 			return err
@@ -211,7 +222,7 @@ func resourceLinodeNodeBalancerUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceLinodeNodeBalancerDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode NodeBalancer id %s as int", d.Id())
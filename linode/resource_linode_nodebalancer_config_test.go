@@ -106,7 +106,7 @@ func TestAccLinodeNodeBalancerConfig_update(t *testing.T) {
 }
 
 func testAccCheckLinodeNodeBalancerConfigExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_nodebalancer_config" {
@@ -132,10 +132,11 @@ func testAccCheckLinodeNodeBalancerConfigExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeNodeBalancerConfigDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_nodebalancer_config" {
 			continue
@@ -69,7 +69,7 @@ func resourceLinodeNodeBalancerNode() *schema.Resource {
 }
 
 func resourceLinodeNodeBalancerNodeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return false, fmt.Errorf("Error parsing Linode NodeBalancerNode ID %s as int: %s", d.Id(), err)
@@ -95,7 +95,7 @@ func resourceLinodeNodeBalancerNodeExists(d *schema.ResourceData, meta interface
 }
 
 func resourceLinodeNodeBalancerNodeRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode NodeBalancerNode ID %s as int: %s", d.Id(), err)
@@ -165,10 +165,11 @@ func resourceLinodeNodeBalancerNodeImport(d *schema.ResourceData, meta interface
 }
 
 func resourceLinodeNodeBalancerNodeCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode NodeBalancerNode")
 	}
+	client := providerMeta.Client
 
 	nodebalancerID, ok := d.Get("nodebalancer_id").(int)
 	if !ok {
@@ -197,7 +198,7 @@ func resourceLinodeNodeBalancerNodeCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceLinodeNodeBalancerNodeUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -227,7 +228,7 @@ func resourceLinodeNodeBalancerNodeUpdate(d *schema.ResourceData, meta interface
 }
 
 func resourceLinodeNodeBalancerNodeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode NodeBalancerConfig ID %s as int: %s", d.Id(), err)
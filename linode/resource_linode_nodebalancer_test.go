@@ -37,6 +37,7 @@ func TestAccLinodeNodeBalancer_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resName, "created"),
 					resource.TestCheckResourceAttrSet(resName, "updated"),
 					resource.TestCheckResourceAttr(resName, "transfer.%", "3"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
 				),
 			},
 
@@ -74,6 +75,7 @@ func TestAccLinodeNodeBalancer_update(t *testing.T) {
 					testAccCheckLinodeNodeBalancerExists,
 					resource.TestCheckResourceAttr(resName, "label", fmt.Sprintf("%s_r", nodebalancerName)),
 					resource.TestCheckResourceAttr(resName, "client_conn_throttle", "0"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "2"),
 				),
 			},
 		},
@@ -81,7 +83,7 @@ func TestAccLinodeNodeBalancer_update(t *testing.T) {
 }
 
 func testAccCheckLinodeNodeBalancerExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_nodebalancer" {
@@ -103,10 +105,11 @@ func testAccCheckLinodeNodeBalancerExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeNodeBalancerDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_nodebalancer" {
 			continue
@@ -140,6 +143,7 @@ resource "linode_nodebalancer" "foobar" {
 	label = "%s"
 	region = "us-east"
 	client_conn_throttle = 20
+	tags = ["tf_test"]
 }
 `, nodebalancer)
 }
@@ -150,6 +154,7 @@ resource "linode_nodebalancer" "foobar" {
 	label = "%s_r"
 	region = "us-east"
 	client_conn_throttle = 0
+	tags = ["tf_test", "tf_test_r"]
 }
 `, nodebalancer)
 }
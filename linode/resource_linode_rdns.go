@@ -0,0 +1,149 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+func resourceLinodeRDNS() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeRDNSCreate,
+		Read:   resourceLinodeRDNSRead,
+		Update: resourceLinodeRDNSUpdate,
+		Delete: resourceLinodeRDNSDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"address": &schema.Schema{
+				Type:             schema.TypeString,
+				Description:      "The public IPv4 or IPv6 address of a Linode Instance or NodeBalancer to set the reverse DNS of.",
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.SingleIP(),
+				DiffSuppressFunc: equivalentIPAddresses,
+			},
+			"rdns": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The reverse DNS name of the address. The name must resolve to the address before it can be set.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 254),
+			},
+		},
+	}
+}
+
+func resourceLinodeRDNSRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	ip, err := client.GetIPAddress(context.Background(), d.Id())
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode RDNS %q from state because the address no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode IP address %s: %s", d.Id(), err)
+	}
+
+	d.Set("address", ip.Address)
+	d.Set("rdns", ip.RDNS)
+
+	return nil
+}
+
+func resourceLinodeRDNSCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode RDNS")
+	}
+	client := providerMeta.Client
+
+	address := d.Get("address").(string)
+	rdns := d.Get("rdns").(string)
+
+	if err := updateIPAddressRDNS(client, address, &rdns, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	d.SetId(address)
+
+	return resourceLinodeRDNSRead(d, meta)
+}
+
+func resourceLinodeRDNSUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	if d.HasChange("rdns") {
+		rdns := d.Get("rdns").(string)
+		if err := updateIPAddressRDNS(client, d.Id(), &rdns, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceLinodeRDNSRead(d, meta)
+}
+
+func resourceLinodeRDNSDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+
+	// Clearing the reverse DNS resets it to the default Linode name
+	if _, err := client.UpdateIPAddress(context.Background(), d.Id(), linodego.IPAddressUpdateOptions{RDNS: nil}); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Error clearing the reverse DNS of Linode IP address %s: %s", d.Id(), err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateIPAddressRDNS sets the reverse DNS of an address, retrying while the forward DNS of the name has not propagated yet
+func updateIPAddressRDNS(client linodego.Client, address string, rdns *string, timeout time.Duration) error {
+	updateOpts := linodego.IPAddressUpdateOptions{RDNS: rdns}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := client.UpdateIPAddress(context.Background(), address, updateOpts)
+		if err == nil {
+			return nil
+		}
+		if isRDNSUnresolvedError(err) {
+			log.Printf("[INFO] Waiting for %s to resolve to Linode IP address %s: %s", *rdns, address, err)
+			return resource.RetryableError(fmt.Errorf("Error setting the reverse DNS of Linode IP address %s to %s: %s", address, *rdns, err))
+		}
+		return resource.NonRetryableError(fmt.Errorf("Error setting the reverse DNS of Linode IP address %s to %s: %s", address, *rdns, err))
+	})
+}
+
+// isRDNSUnresolvedError reports whether the API rejected a reverse DNS name only because it does not resolve to the address yet.
+// Other errors on the name, such as an invalid hostname, can not be fixed by waiting.
+func isRDNSUnresolvedError(err error) bool {
+	lerr, ok := err.(*linodego.Error)
+	if !ok || lerr.Code != 400 {
+		return false
+	}
+	for _, reason := range strings.Split(lerr.Message, "; ") {
+		if strings.HasPrefix(reason, "[rdns]") && strings.Contains(strings.ToLower(reason), "does not resolve") {
+			return true
+		}
+	}
+	return false
+}
+
+// equivalentIPAddresses suppresses the diff between different notations of the same IP address
+func equivalentIPAddresses(k, old, new string, d *schema.ResourceData) bool {
+	oldIP, newIP := net.ParseIP(old), net.ParseIP(new)
+	return oldIP != nil && oldIP.Equal(newIP)
+}
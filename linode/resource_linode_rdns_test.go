@@ -0,0 +1,97 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeRDNS_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_rdns.foobar"
+	instanceName := acctest.RandomWithPrefix("tf_test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeRDNSConfigBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeRDNSExists,
+					resource.TestCheckResourceAttrPair(resName, "address", "linode_instance.foobar", "ip_address"),
+					resource.TestMatchResourceAttr(resName, "rdns", regexp.MustCompile(`\.nip\.io$`)),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestLinodeRDNSUnresolvedError(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected bool
+	}{
+		{&linodego.Error{Code: 400, Message: "[rdns] Domain does not resolve to this IP address"}, true},
+		{&linodego.Error{Code: 400, Message: "[label] Invalid label; [rdns] Domain does not resolve to this IP address"}, true},
+		{&linodego.Error{Code: 400, Message: "[rdns] Invalid hostname"}, false},
+		{&linodego.Error{Code: 400, Message: "[address] Domain does not resolve to this IP address"}, false},
+		{&linodego.Error{Code: 500, Message: "[rdns] Domain does not resolve to this IP address"}, false},
+		{fmt.Errorf("[rdns] Domain does not resolve to this IP address"), false},
+	}
+
+	for _, c := range cases {
+		if unresolved := isRDNSUnresolvedError(c.err); unresolved != c.expected {
+			t.Errorf("expected %q to be retried %t, got %t", c.err, c.expected, unresolved)
+		}
+	}
+}
+
+func testAccCheckLinodeRDNSExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_rdns" {
+			continue
+		}
+
+		ip, err := client.GetIPAddress(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Linode IP address %s: %s", rs.Primary.ID, err)
+		}
+		if ip.RDNS != rs.Primary.Attributes["rdns"] {
+			return fmt.Errorf("Expected the reverse DNS of Linode IP address %s to be %s, got %s", rs.Primary.ID, rs.Primary.Attributes["rdns"], ip.RDNS)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeRDNSConfigBasic(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	group = "tf_test"
+	type = "g6-nanode-1"
+	region = "us-east"
+	booted = false
+}
+
+resource "linode_rdns" "foobar" {
+	address = "${linode_instance.foobar.ip_address}"
+	rdns = "${linode_instance.foobar.ip_address}.nip.io"
+}`, instance)
+}
@@ -88,7 +88,7 @@ func TestAccLinodeSSHKey_update(t *testing.T) {
 }
 
 func testAccCheckLinodeSSHKeyExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_sshkey" {
@@ -110,10 +110,11 @@ func testAccCheckLinodeSSHKeyExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeSSHKeyDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_sshkey" {
 			continue
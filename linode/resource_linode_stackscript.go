@@ -123,7 +123,7 @@ func resourceLinodeStackscript() *schema.Resource {
 }
 
 func resourceLinodeStackscriptExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return false, fmt.Errorf("Error parsing Linode Stackscript ID %s as int: %s", d.Id(), err)
@@ -141,7 +141,7 @@ func resourceLinodeStackscriptExists(d *schema.ResourceData, meta interface{}) (
 }
 
 func resourceLinodeStackscriptRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Stackscript ID %s as int: %s", d.Id(), err)
@@ -222,10 +222,11 @@ func resourceLinodeStackscriptRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceLinodeStackscriptCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode Stackscript")
 	}
+	client := providerMeta.Client
 
 	createOpts := linodego.StackscriptCreateOptions{
 		Label:       d.Get("label").(string),
@@ -249,7 +250,7 @@ func resourceLinodeStackscriptCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLinodeStackscriptUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -276,7 +277,7 @@ func resourceLinodeStackscriptUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceLinodeStackscriptDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Stackscript id %s as int", d.Id())
@@ -130,7 +130,7 @@ func TestAccLinodeStackscript_codeChange(t *testing.T) {
 }
 
 func testAccCheckLinodeStackscriptExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_stackscript" {
@@ -152,10 +152,11 @@ func testAccCheckLinodeStackscriptExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeStackscriptDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_stackscript" {
 			continue
@@ -0,0 +1,253 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/linode/linodego"
+)
+
+// taggedEntityKeys are the linode_tag attributes holding the IDs of each type of tagged entity
+var taggedEntityKeys = []string{"instances", "volumes", "nodebalancers", "domains"}
+
+func resourceLinodeTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeTagCreate,
+		Read:   resourceLinodeTagRead,
+		Update: resourceLinodeTagUpdate,
+		Delete: resourceLinodeTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"label": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The label of the Linode Tag.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 50),
+			},
+			"instances": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The IDs of the Linode Instances to apply this Tag to.",
+				Optional:    true,
+			},
+			"volumes": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The IDs of the Linode Volumes to apply this Tag to.",
+				Optional:    true,
+			},
+			"nodebalancers": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The IDs of the Linode NodeBalancers to apply this Tag to.",
+				Optional:    true,
+			},
+			"domains": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Set:         schema.HashInt,
+				Description: "The IDs of the Linode Domains to apply this Tag to.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeTagRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	label := d.Id()
+
+	taggedObjects, err := client.ListTaggedObjects(context.Background(), label, nil)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] removing Linode Tag %q from state because it no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error finding the specified Linode Tag %s: %s", label, err)
+	}
+
+	sorted, err := taggedObjects.SortedObjects()
+	if err != nil {
+		return fmt.Errorf("Error parsing the objects tagged with Linode Tag %s: %s", label, err)
+	}
+
+	instances := make([]int, len(sorted.Instances))
+	for i, instance := range sorted.Instances {
+		instances[i] = instance.ID
+	}
+	volumes := make([]int, len(sorted.Volumes))
+	for i, volume := range sorted.Volumes {
+		volumes[i] = volume.ID
+	}
+	nodebalancers := make([]int, len(sorted.NodeBalancers))
+	for i, nodebalancer := range sorted.NodeBalancers {
+		nodebalancers[i] = nodebalancer.ID
+	}
+	domains := make([]int, len(sorted.Domains))
+	for i, domain := range sorted.Domains {
+		domains[i] = domain.ID
+	}
+
+	d.Set("label", label)
+	d.Set("instances", instances)
+	d.Set("volumes", volumes)
+	d.Set("nodebalancers", nodebalancers)
+	d.Set("domains", domains)
+
+	return nil
+}
+
+func resourceLinodeTagCreate(d *schema.ResourceData, meta interface{}) error {
+	providerMeta, ok := meta.(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Tag")
+	}
+	client := providerMeta.Client
+
+	createOpts := linodego.TagCreateOptions{
+		Label:         d.Get("label").(string),
+		Linodes:       expandIntSet(d.Get("instances")),
+		Volumes:       expandIntSet(d.Get("volumes")),
+		NodeBalancers: expandIntSet(d.Get("nodebalancers")),
+		Domains:       expandIntSet(d.Get("domains")),
+	}
+
+	tag, err := client.CreateTag(context.Background(), createOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating a Linode Tag: %s", err)
+	}
+	d.SetId(tag.Label)
+
+	return resourceLinodeTagRead(d, meta)
+}
+
+func resourceLinodeTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	label := d.Id()
+
+	d.Partial(true)
+	for _, key := range taggedEntityKeys {
+		if !d.HasChange(key) {
+			continue
+		}
+
+		o, n := d.GetChange(key)
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		for _, id := range expandIntSet(newSet.Difference(oldSet)) {
+			if err := changeEntityTag(client, key, id, label, true); err != nil {
+				return err
+			}
+		}
+		for _, id := range expandIntSet(oldSet.Difference(newSet)) {
+			if err := changeEntityTag(client, key, id, label, false); err != nil {
+				return err
+			}
+		}
+		d.SetPartial(key)
+	}
+	d.Partial(false)
+
+	return resourceLinodeTagRead(d, meta)
+}
+
+func resourceLinodeTagDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ProviderMeta).Client
+	label := d.Id()
+
+	if err := client.DeleteTag(context.Background(), label); err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Linode Tag %s: %s", label, err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// changeTagList returns a sorted copy of tags with label added or removed
+func changeTagList(tags []string, label string, add bool) []string {
+	changed := make([]string, 0, len(tags)+1)
+	for _, tag := range tags {
+		if tag != label {
+			changed = append(changed, tag)
+		}
+	}
+	if add {
+		changed = append(changed, label)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// changeEntityTag adds or removes a tag from the entity identified by the linode_tag attribute key and ID
+func changeEntityTag(client linodego.Client, key string, id int, label string, add bool) error {
+	ctx := context.Background()
+
+	// entities that have been deleted no longer carry the tag being removed
+	missing := func(err error) bool {
+		lerr, ok := err.(*linodego.Error)
+		return !add && ok && lerr.Code == 404
+	}
+
+	switch key {
+	case "instances":
+		instance, err := client.GetInstance(ctx, id)
+		if missing(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("Error fetching Linode Instance %d to tag with %s: %s", id, label, err)
+		}
+		tags := changeTagList(instance.Tags, label, add)
+		if _, err := client.UpdateInstance(ctx, id, linodego.InstanceUpdateOptions{Tags: &tags}); err != nil {
+			return fmt.Errorf("Error updating the tags of Linode Instance %d: %s", id, err)
+		}
+	case "volumes":
+		volume, err := client.GetVolume(ctx, id)
+		if missing(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("Error fetching Linode Volume %d to tag with %s: %s", id, label, err)
+		}
+		tags := changeTagList(volume.Tags, label, add)
+		if _, err := client.UpdateVolume(ctx, id, linodego.VolumeUpdateOptions{Tags: &tags}); err != nil {
+			return fmt.Errorf("Error updating the tags of Linode Volume %d: %s", id, err)
+		}
+	case "nodebalancers":
+		nodebalancer, err := client.GetNodeBalancer(ctx, id)
+		if missing(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("Error fetching Linode NodeBalancer %d to tag with %s: %s", id, label, err)
+		}
+		tags := changeTagList(nodebalancer.Tags, label, add)
+		if _, err := client.UpdateNodeBalancer(ctx, id, linodego.NodeBalancerUpdateOptions{Tags: &tags}); err != nil {
+			return fmt.Errorf("Error updating the tags of Linode NodeBalancer %d: %s", id, err)
+		}
+	case "domains":
+		domain, err := client.GetDomain(ctx, id)
+		if missing(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("Error fetching Linode Domain %d to tag with %s: %s", id, label, err)
+		}
+		tags := changeTagList(domain.Tags, label, add)
+		if _, err := client.UpdateDomain(ctx, id, linodego.DomainUpdateOptions{Tags: &tags}); err != nil {
+			return fmt.Errorf("Error updating the tags of Linode Domain %d: %s", id, err)
+		}
+	default:
+		return fmt.Errorf("Error tagging %s %d: unknown entity type", key, id)
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/linode/linodego"
+)
+
+func TestAccLinodeTag_changeTagList(t *testing.T) {
+	t.Parallel()
+
+	if tags := changeTagList([]string{"b", "a"}, "c", true); !reflect.DeepEqual(tags, []string{"a", "b", "c"}) {
+		t.Errorf("should add and sort tags, got %v", tags)
+	}
+	if tags := changeTagList([]string{"a", "c"}, "c", true); !reflect.DeepEqual(tags, []string{"a", "c"}) {
+		t.Errorf("should not duplicate an existing tag, got %v", tags)
+	}
+	if tags := changeTagList([]string{"a", "c"}, "c", false); !reflect.DeepEqual(tags, []string{"a"}) {
+		t.Errorf("should remove the tag, got %v", tags)
+	}
+	if tags := changeTagList(nil, "c", false); tags == nil || len(tags) != 0 {
+		t.Errorf("should return an empty non-nil list, got %#v", tags)
+	}
+}
+
+func TestAccLinodeTag_basic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_tag.foobar"
+	tagName := acctest.RandomWithPrefix("tf_test")
+	domainName := acctest.RandomWithPrefix("tf-test-") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeTagDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeTagConfigBasic(tagName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeTagExists,
+					resource.TestCheckResourceAttr(resName, "label", tagName),
+					resource.TestCheckResourceAttr(resName, "volumes.#", "1"),
+					resource.TestCheckResourceAttr(resName, "domains.#", "1"),
+					resource.TestCheckResourceAttr(resName, "instances.#", "0"),
+				),
+			},
+
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLinodeTag_update(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_tag.foobar"
+	tagName := acctest.RandomWithPrefix("tf_test")
+	domainName := acctest.RandomWithPrefix("tf-test-") + ".example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeTagDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeTagConfigBasic(tagName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeTagExists,
+					resource.TestCheckResourceAttr(resName, "volumes.#", "1"),
+					resource.TestCheckResourceAttr(resName, "domains.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeTagConfigUpdates(tagName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeTagExists,
+					resource.TestCheckResourceAttr(resName, "volumes.#", "2"),
+					resource.TestCheckResourceAttr(resName, "domains.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeTagExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ProviderMeta).Client
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_tag" {
+			continue
+		}
+
+		_, err := client.ListTaggedObjects(context.Background(), rs.Primary.ID, nil)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Tag %s: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeTagDestroy(s *terraform.State) error {
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
+	if !ok {
+		return fmt.Errorf("Error getting Linode client")
+	}
+	client := providerMeta.Client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_tag" {
+			continue
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Would have considered an empty Tag label")
+		}
+
+		_, err := client.ListTaggedObjects(context.Background(), rs.Primary.ID, nil)
+
+		if err == nil {
+			return fmt.Errorf("Linode Tag %s still exists", rs.Primary.ID)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Error requesting Linode Tag %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeTagConfigBasic(tag string, domain string) string {
+	return fmt.Sprintf(`
+resource "linode_volume" "foobar" {
+	label = "%s"
+	region = "us-west"
+	tags = ["tf_test"]
+}
+
+resource "linode_domain" "foobar" {
+	domain = "%s"
+	type = "master"
+	soa_email = "example@%s"
+	tags = ["tf_test"]
+}
+
+resource "linode_tag" "foobar" {
+	label = "%s"
+	volumes = ["${linode_volume.foobar.id}"]
+	domains = ["${linode_domain.foobar.id}"]
+}`, tag, domain, domain, tag)
+}
+
+func testAccCheckLinodeTagConfigUpdates(tag string, domain string) string {
+	return fmt.Sprintf(`
+resource "linode_volume" "foobar" {
+	label = "%s"
+	region = "us-west"
+	tags = ["tf_test"]
+}
+
+resource "linode_volume" "foobaz" {
+	label = "%s_baz"
+	region = "us-west"
+	tags = ["tf_test"]
+}
+
+resource "linode_domain" "foobar" {
+	domain = "%s"
+	type = "master"
+	soa_email = "example@%s"
+	tags = ["tf_test"]
+}
+
+resource "linode_tag" "foobar" {
+	label = "%s"
+	volumes = ["${linode_volume.foobar.id}", "${linode_volume.foobaz.id}"]
+}`, tag, tag, domain, domain, tag)
+}
@@ -71,7 +71,7 @@ func TestAccLinodeTemplate_update(t *testing.T) {
 }
 
 func testAccCheckLinodeTemplateExists(s *terraform.State) error {
-	client := testAccProvider.Meta().(linodego.Client)
+	client := testAccProvider.Meta().(*ProviderMeta).Client
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_template" {
@@ -93,10 +93,11 @@ func testAccCheckLinodeTemplateExists(s *terraform.State) error {
 }
 
 func testAccCheckLinodeTemplateDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_template" {
 			continue
@@ -17,8 +17,11 @@ func resourceLinodeVolume() *schema.Resource {
 		Update: resourceLinodeVolumeUpdate,
 		Delete: resourceLinodeVolumeDelete,
 		Exists: resourceLinodeVolumeExists,
+
+		CustomizeDiff: customizeDiffTags,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateWithTags(resourceLinodeVolumeRead),
 		},
 		Schema: map[string]*schema.Schema{
 			"label": &schema.Schema{
@@ -26,6 +29,8 @@ func resourceLinodeVolume() *schema.Resource {
 				Description: "The label of the Linode Volume.",
 				Required:    true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"status": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "The status of the volume, indicating the current readiness state.",
@@ -60,7 +65,7 @@ func resourceLinodeVolume() *schema.Resource {
 }
 
 func resourceLinodeVolumeExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return false, fmt.Errorf("Error parsing Linode Volume ID %s as int: %s", d.Id(), err)
@@ -78,7 +83,7 @@ func resourceLinodeVolumeExists(d *schema.ResourceData, meta interface{}) (bool,
 }
 
 func resourceLinodeVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Volume ID %s as int: %s", d.Id(), err)
@@ -101,15 +106,18 @@ func resourceLinodeVolumeRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("size", volume.Size)
 	d.Set("linode_id", volume.LinodeID)
 	d.Set("filesystem_path", volume.FilesystemPath)
+	d.Set("tags", flattenTags(d, meta, volume.Tags))
+	d.Set("tags_all", flattenTagsAll(d, meta, volume.Tags))
 
 	return nil
 }
 
 func resourceLinodeVolumeCreate(d *schema.ResourceData, meta interface{}) error {
-	client, ok := meta.(linodego.Client)
+	providerMeta, ok := meta.(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Invalid Client when creating Linode Volume")
 	}
+	client := providerMeta.Client
 	d.Partial(true)
 
 	var linodeID *int
@@ -118,6 +126,7 @@ func resourceLinodeVolumeCreate(d *schema.ResourceData, meta interface{}) error
 		Label:  d.Get("label").(string),
 		Region: d.Get("region").(string),
 		Size:   d.Get("size").(int),
+		Tags:   expandTags(d, meta, nil),
 	}
 
 	if lID, ok := d.GetOk("linode_id"); ok {
@@ -135,6 +144,7 @@ func resourceLinodeVolumeCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetPartial("label")
 	d.SetPartial("region")
 	d.SetPartial("size")
+	d.SetPartial("tags")
 
 	if createOpts.LinodeID > 0 {
 		if _, err := client.WaitForVolumeLinodeID(context.Background(), volume.ID, linodeID, int(d.Timeout("update").Seconds())); err != nil {
@@ -153,7 +163,7 @@ func resourceLinodeVolumeCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLinodeVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	d.Partial(true)
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -180,12 +190,23 @@ func resourceLinodeVolumeUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("size")
 	}
 
-	if d.HasChange("label") {
-		if volume, err = client.RenameVolume(context.Background(), volume.ID, d.Get("label").(string)); err != nil {
+	if d.HasChange("label") || hasTagsChange(d) {
+		updateOpts := linodego.VolumeUpdateOptions{
+			Label: d.Get("label").(string),
+		}
+		if hasTagsChange(d) {
+			tags := expandTags(d, meta, volume.Tags)
+			updateOpts.Tags = &tags
+		}
+		if volume, err = client.UpdateVolume(context.Background(), volume.ID, updateOpts); err != nil {
 			return err
 		}
 		d.Set("label", volume.Label)
+		d.Set("tags", flattenTags(d, meta, volume.Tags))
+		d.Set("tags_all", flattenTagsAll(d, meta, volume.Tags))
 		d.SetPartial("label")
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	var linodeID *int
@@ -238,7 +259,7 @@ func resourceLinodeVolumeUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceLinodeVolumeDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(linodego.Client)
+	client := meta.(*ProviderMeta).Client
 	id64, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return fmt.Errorf("Error parsing Linode Volume id %s as int", d.Id())
@@ -3,6 +3,7 @@ package linode
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -53,6 +54,7 @@ func TestAccLinodeVolume_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "label", volumeName),
 					resource.TestCheckResourceAttr(resName, "region", "us-west"),
 					resource.TestCheckResourceAttr(resName, "linode_id", "0"),
+					resource.TestCheckResourceAttr(resName, "tags.#", "1"),
 				),
 			},
 
@@ -88,6 +90,7 @@ func TestAccLinodeVolume_update(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeVolumeExists("linode_volume.foobar", &volume),
 					resource.TestCheckResourceAttr("linode_volume.foobar", "label", fmt.Sprintf("%s_r", volumeName)),
+					resource.TestCheckResourceAttr("linode_volume.foobar", "tags.#", "2"),
 				),
 			},
 		},
@@ -123,6 +126,35 @@ func TestAccLinodeVolume_resized(t *testing.T) {
 	})
 }
 
+func TestAccLinodeVolume_providerTags(t *testing.T) {
+	t.Parallel()
+
+	var volumeName = acctest.RandomWithPrefix("tf_test")
+	var volume = linodego.Volume{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeVolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeVolumeConfigProviderTags(volumeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeVolumeExists("linode_volume.foobar", &volume),
+					resource.TestCheckResourceAttr("linode_volume.foobar", "tags.#", "1"),
+					resource.TestCheckResourceAttr("linode_volume.foobar", "tags_all.#", "2"),
+					func(*terraform.State) error {
+						if !reflect.DeepEqual(volume.Tags, []string{"tf_test", "tf_test_default"}) {
+							return fmt.Errorf("Expected the provider default_tags to be applied, got %v", volume.Tags)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccLinodeVolume_attached(t *testing.T) {
 	t.Parallel()
 
@@ -243,7 +275,7 @@ func TestAccLinodeVolume_reattachedBetweenInstances(t *testing.T) {
 
 func testAccCheckLinodeVolumeExists(name string, volume *linodego.Volume) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		client := testAccProvider.Meta().(linodego.Client)
+		client := testAccProvider.Meta().(*ProviderMeta).Client
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -271,10 +303,11 @@ func testAccCheckLinodeVolumeExists(name string, volume *linodego.Volume) resour
 }
 
 func testAccCheckLinodeVolumeDestroy(s *terraform.State) error {
-	client, ok := testAccProvider.Meta().(linodego.Client)
+	providerMeta, ok := testAccProvider.Meta().(*ProviderMeta)
 	if !ok {
 		return fmt.Errorf("Error getting Linode client")
 	}
+	client := providerMeta.Client
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "linode_volume" {
 			continue
@@ -308,6 +341,21 @@ func testAccCheckLinodeVolumeConfigBasic(volume string) string {
 resource "linode_volume" "foobar" {
 	label = "%s"
 	region = "us-west"
+	tags = ["tf_test"]
+}`, volume)
+}
+
+func testAccCheckLinodeVolumeConfigProviderTags(volume string) string {
+	return fmt.Sprintf(`
+provider "linode" {
+	default_tags = ["tf_test_default"]
+	ignore_tags = ["tf_test_ignored"]
+}
+
+resource "linode_volume" "foobar" {
+	label = "%s"
+	region = "us-west"
+	tags = ["tf_test"]
 }`, volume)
 }
 
@@ -316,6 +364,7 @@ func testAccCheckLinodeVolumeConfigUpdates(volume string) string {
 resource "linode_volume" "foobar" {
 	label = "%s_r"
 	region = "us-west"
+	tags = ["tf_test", "tf_test_r"]
 }`, volume)
 }
 
@@ -337,8 +386,9 @@ resource "linode_instance" "foobar" {
 	config {
 		label = "config"
 		kernel = "linode/latest-64bit"
-		devices = {
-			sda = { volume_id = "${linode_volume.foobar.id}" }, 
+		device {
+			slot = "sda"
+			volume_id = "${linode_volume.foobar.id}"
 		}
 	}
 }
@@ -358,8 +408,9 @@ resource "linode_instance" "foobar" {
 	config {
 		label = "config"
 		kernel = "linode/latest-64bit"
-		devices = {
-			sda = { volume_id = "${linode_volume.foobaz.id}" }, 
+		device {
+			slot = "sda"
+			volume_id = "${linode_volume.foobaz.id}"
 		}
 	}
 }
@@ -371,8 +422,9 @@ resource "linode_instance" "foobaz" {
 	config {
 		label = "config"
 		kernel = "linode/latest-64bit"
-		devices = {
-			sda = { volume_id = "${linode_volume.foobar.id}" }, 
+		device {
+			slot = "sda"
+			volume_id = "${linode_volume.foobar.id}"
 		}
 	}
 }